@@ -0,0 +1,177 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+type bulkChunkResult struct {
+	inputs []BulkInput
+	resp   *common.Response
+	err    error
+}
+
+type bulkResponseEnvelope struct {
+	Status   json.RawMessage   `json:"status"`
+	Requests []json.RawMessage `json:"requests"`
+}
+
+//sendRequestBulkChunked splits the inputs into bulk requests with max MaxBulkRequestsCount sub-requests, sends them
+//with the limited concurrency and merges the sub-responses in the original order of inputs
+func (cli *Client) sendRequestBulkChunked(ctx context.Context, inputs []BulkInput, filters map[string]string) (*common.Response, error) {
+	chunks := make([][]BulkInput, 0, common.CeilDivisionInt(len(inputs), common.MaxBulkRequestsCount))
+	for start := 0; start < len(inputs); start += common.MaxBulkRequestsCount {
+		end := start + common.MaxBulkRequestsCount
+		if end > len(inputs) {
+			end = len(inputs)
+		}
+		chunks = append(chunks, inputs[start:end])
+	}
+
+	concurrency := cli.bulkConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]bulkChunkResult, len(chunks))
+	semaphore := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+	for i, chunk := range chunks {
+		results[i].inputs = chunk
+		semaphore <- struct{}{}
+		if ctx.Err() != nil {
+			<-semaphore
+			results[i].err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, chunk []BulkInput) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			chunkFilters := make(map[string]string, len(filters))
+			for filterKey, filterValue := range filters {
+				chunkFilters[filterKey] = filterValue
+			}
+
+			resp, err := cli.handle(ctx, &common.Request{
+				Filters:    chunkFilters,
+				BulkInputs: chunk,
+				Header:     http.Header{},
+			})
+			results[i].resp, results[i].err = resp, err
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	return mergeBulkChunkResults(results)
+}
+
+//mergeBulkChunkResults joins the sub-responses of the chunks. The sub-requests of the chunks which failed as a whole,
+//e.g. because of a network error, get error statuses, so the successful chunks are kept and the callers report the
+//failed items with BulkError. The error is returned only if all chunks failed
+func mergeBulkChunkResults(results []bulkChunkResult) (*common.Response, error) {
+	var (
+		mergedEnvelope bulkResponseEnvelope
+		firstResp      *common.Response
+		firstErr       error
+		mergedFailed   bool
+	)
+	for _, result := range results {
+		if result.err == nil && result.resp == nil {
+			result.err = common.NewFromError("bulk request was interrupted", nil, 0)
+		}
+
+		var chunkEnvelope bulkResponseEnvelope
+		if result.err == nil {
+			if err := json.Unmarshal(result.resp.Body, &chunkEnvelope); err != nil {
+				//the response is given as it is, so the caller will see the original failure
+				return result.resp, nil
+			}
+		}
+		if result.err != nil {
+			if firstErr == nil {
+				firstErr = result.err
+			}
+			mergedEnvelope.Requests = append(mergedEnvelope.Requests, failedBulkItems(result.inputs, result.err)...)
+			continue
+		}
+
+		//the status of the first failed chunk is given to the caller, otherwise the first status is used
+		chunkFailed := result.resp.Status != nil && !IsJSONResponseOK(result.resp.Status)
+		if firstResp == nil || (chunkFailed && !mergedFailed) {
+			mergedEnvelope.Status = chunkEnvelope.Status
+			mergedFailed = chunkFailed
+		}
+		if firstResp == nil {
+			firstResp = result.resp
+		}
+		//a chunk which failed as a whole can come without sub-responses, it gets the error ones to keep the input order
+		if len(chunkEnvelope.Requests) != len(result.inputs) {
+			chunkErr := common.NewFromError("bulk response doesn't match the sub-requests", nil, 0)
+			if result.resp.Status != nil {
+				chunkErr = common.NewFromResponseStatus(result.resp.Status)
+			}
+			mergedEnvelope.Requests = append(mergedEnvelope.Requests, failedBulkItems(result.inputs, chunkErr)...)
+			continue
+		}
+		mergedEnvelope.Requests = append(mergedEnvelope.Requests, chunkEnvelope.Requests...)
+	}
+	if firstResp == nil {
+		return nil, firstErr
+	}
+
+	body, err := json.Marshal(mergedEnvelope)
+	if err != nil {
+		return nil, common.NewFromError("failed to merge bulk responses", err, 0)
+	}
+
+	httpResp := *firstResp.HTTPResponse
+	httpResp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	resp := &common.Response{
+		HTTPResponse: &httpResp,
+		Body:         body,
+	}
+	resp.Status, resp.BulkStatuses = common.DecodeStatuses(body)
+
+	return resp, nil
+}
+
+//failedBulkItems gives the error sub-responses for the inputs of a chunk which wasn't answered,
+//the error code is taken from the chunk error if it's an ErplyError
+func failedBulkItems(inputs []BulkInput, err error) []json.RawMessage {
+	var code common.ApiError
+	erplyErr := &common.ErplyError{}
+	if errors.As(err, &erplyErr) {
+		code = erplyErr.Code
+	}
+
+	items := make([]json.RawMessage, 0, len(inputs))
+	for _, input := range inputs {
+		status := common.StatusBulk{RequestName: input.MethodName}
+		status.ResponseStatus = "error"
+		status.ErrorCode = code
+		if requestID, ok := input.Filters["requestID"]; ok {
+			status.RequestID = fmt.Sprint(requestID)
+		}
+
+		item, _ := json.Marshal(map[string]interface{}{
+			"status":  status,
+			"records": []interface{}{},
+		})
+		items = append(items, item)
+	}
+
+	return items
+}
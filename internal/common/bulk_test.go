@@ -0,0 +1,238 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+type bulkResponseMock struct {
+	Status   common.Status `json:"status"`
+	Requests []struct {
+		Status  common.StatusBulk `json:"status"`
+		Records []struct {
+			ID string `json:"id"`
+		} `json:"records"`
+	} `json:"requests"`
+}
+
+func newBulkEchoServer(t *testing.T, failingID string) (*httptest.Server, *[]int) {
+	lock := sync.Mutex{}
+	chunkSizes := []int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requests []map[string]interface{}
+		err := json.Unmarshal([]byte(r.FormValue("requests")), &requests)
+		assert.NoError(t, err)
+
+		lock.Lock()
+		chunkSizes = append(chunkSizes, len(requests))
+		lock.Unlock()
+
+		status := common.Status{ResponseStatus: "ok"}
+		bulkItems := make([]interface{}, 0, len(requests))
+		for _, request := range requests {
+			if request["id"] == failingID {
+				status = common.Status{ResponseStatus: "error", ErrorCode: common.DbError}
+			}
+			bulkItems = append(bulkItems, map[string]interface{}{
+				"status":  common.StatusBulk{RequestID: request["requestID"].(string), Status: common.Status{ResponseStatus: "ok"}},
+				"records": []interface{}{map[string]interface{}{"id": request["id"]}},
+			})
+		}
+
+		jsonRaw, err := json.Marshal(map[string]interface{}{
+			"status":   status,
+			"requests": bulkItems,
+		})
+		assert.NoError(t, err)
+
+		_, err = w.Write(jsonRaw)
+		assert.NoError(t, err)
+	}))
+
+	return srv, &chunkSizes
+}
+
+func buildBulkInputs(count int) []BulkInput {
+	inputs := make([]BulkInput, 0, count)
+	for i := 0; i < count; i++ {
+		inputs = append(inputs, BulkInput{
+			MethodName: "saveProduct",
+			Filters: map[string]interface{}{
+				"id":        fmt.Sprint(i),
+				"requestID": fmt.Sprint(i),
+			},
+		})
+	}
+
+	return inputs
+}
+
+func TestSendRequestBulkChunking(t *testing.T) {
+	for _, concurrency := range []int{0, 1, 3} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			srv, chunkSizes := newBulkEchoServer(t, "")
+			defer srv.Close()
+
+			constr := &ClientConstructor{}
+			constr.WithURL(srv.URL)
+			constr.WithBulkConcurrency(concurrency)
+			cli := constr.Build()
+
+			resp, err := cli.SendRequestBulk(context.Background(), buildBulkInputs(250), map[string]string{})
+			assert.NoError(t, err)
+			if err != nil {
+				return
+			}
+
+			assert.ElementsMatch(t, []int{100, 100, 50}, *chunkSizes)
+
+			body, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+
+			var bulkResp bulkResponseMock
+			err = json.Unmarshal(body, &bulkResp)
+			assert.NoError(t, err)
+
+			assert.Equal(t, "ok", bulkResp.Status.ResponseStatus)
+			assert.Len(t, bulkResp.Requests, 250)
+			for i, bulkItem := range bulkResp.Requests {
+				assert.Equal(t, fmt.Sprint(i), bulkItem.Status.RequestID)
+				assert.Equal(t, fmt.Sprint(i), bulkItem.Records[0].ID)
+			}
+		})
+	}
+}
+
+func TestSendRequestBulkChunkFailure(t *testing.T) {
+	srv, _ := newBulkEchoServer(t, "150")
+	defer srv.Close()
+
+	cli := NewClientWithURL("somesess", "someclient", "", srv.URL, nil, nil)
+
+	resp, err := cli.SendRequestBulk(context.Background(), buildBulkInputs(250), map[string]string{})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	var bulkResp bulkResponseMock
+	err = json.NewDecoder(resp.Body).Decode(&bulkResp)
+	assert.NoError(t, err)
+
+	assert.Equal(t, common.DbError, bulkResp.Status.ErrorCode)
+}
+
+func TestSendRequestBulkChunkTransportFailure(t *testing.T) {
+	srv, _ := newBulkEchoServer(t, "")
+	srv.Close()
+
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithBulkConcurrency(2)
+	cli := constr.Build()
+
+	_, err := cli.SendRequestBulk(context.Background(), buildBulkInputs(250), map[string]string{})
+	assert.Error(t, err)
+}
+
+func TestSendRequestBulkChunkPartialFailure(t *testing.T) {
+	srv, chunkSizes := newBulkEchoServer(t, "")
+	defer srv.Close()
+
+	chunkErr := common.NewFromError("connection reset", nil, common.ServerMaintenance)
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithBulkConcurrency(2)
+	constr.WithMiddlewares(func(next common.RequestHandler) common.RequestHandler {
+		return func(ctx context.Context, req *common.Request) (*common.Response, error) {
+			if req.BulkInputs[0].Filters["id"] == "100" {
+				return nil, chunkErr
+			}
+			return next(ctx, req)
+		}
+	})
+	cli := constr.Build()
+
+	bulkResp, err := cli.ScanBulk(context.Background(), buildBulkInputs(250), map[string]string{})
+	assert.ElementsMatch(t, []int{100, 50}, *chunkSizes)
+
+	bulkErr := &common.BulkError{}
+	if !assert.True(t, errors.As(err, &bulkErr)) {
+		return
+	}
+	assert.Equal(t, 250, bulkErr.ItemsCount)
+	assert.Len(t, bulkErr.Failures, 100)
+	assert.Equal(t, common.BulkItemError{
+		Index:       100,
+		RequestID:   "100",
+		RequestName: "saveProduct",
+		Code:        common.ServerMaintenance,
+		Status:      "error",
+	}, bulkErr.Failures[0])
+	assert.Equal(t, 199, bulkErr.Failures[99].Index)
+
+	assert.Equal(t, "ok", bulkResp.Status.ResponseStatus)
+	if assert.Len(t, bulkResp.BulkItems, 250) {
+		assert.Equal(t, "99", bulkResp.BulkItems[99].Status.RequestID)
+		assert.Equal(t, "ok", bulkResp.BulkItems[99].Status.ResponseStatus)
+		assert.Equal(t, "error", bulkResp.BulkItems[150].Status.ResponseStatus)
+		assert.Equal(t, "ok", bulkResp.BulkItems[200].Status.ResponseStatus)
+	}
+}
+
+func TestSendRequestBulkChunkWithoutSubResponses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requests []map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(r.FormValue("requests")), &requests))
+
+		if requests[0]["id"] == "0" {
+			_, err := w.Write([]byte(`{"status":{"responseStatus":"error","errorCode":1002},"requests":[]}`))
+			assert.NoError(t, err)
+			return
+		}
+
+		bulkItems := make([]interface{}, 0, len(requests))
+		for _, request := range requests {
+			bulkItems = append(bulkItems, map[string]interface{}{
+				"status":  common.StatusBulk{RequestID: request["requestID"].(string), Status: common.Status{ResponseStatus: "ok"}},
+				"records": []interface{}{},
+			})
+		}
+		jsonRaw, err := json.Marshal(map[string]interface{}{"status": common.Status{ResponseStatus: "ok"}, "requests": bulkItems})
+		assert.NoError(t, err)
+		_, err = w.Write(jsonRaw)
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	cli := constr.Build()
+
+	resp, err := cli.SendRequestBulk(context.Background(), buildBulkInputs(150), map[string]string{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	bulkResp := common.BulkResponse{}
+	assert.NoError(t, json.Unmarshal(body, &bulkResp))
+	assert.Equal(t, common.HourlyRequestQuota, bulkResp.Status.ErrorCode)
+	if assert.Len(t, bulkResp.BulkItems, 150) {
+		assert.Equal(t, "0", bulkResp.BulkItems[0].Status.RequestID)
+		assert.Equal(t, "error", bulkResp.BulkItems[0].Status.ResponseStatus)
+		assert.Equal(t, common.HourlyRequestQuota, bulkResp.BulkItems[99].Status.ErrorCode)
+		assert.Equal(t, "100", bulkResp.BulkItems[100].Status.RequestID)
+		assert.Equal(t, "ok", bulkResp.BulkItems[100].Status.ResponseStatus)
+	}
+}
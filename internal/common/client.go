@@ -1,8 +1,11 @@
 package common
 
 import (
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/log"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 type AuthFunc func(string) url.Values
@@ -39,6 +42,16 @@ type ClientConstructor struct {
 	httpCli                    *http.Client
 	headersForEveryRequestFunc AuthFunc
 	sessionProvider            SessionProvider
+	middlewares                []common.Middleware
+	retryPolicy                *common.RetryPolicy
+	rateLimiter                common.RateLimiter
+	quotaTracker               *common.QuotaTracker
+	bulkConcurrency            int
+	logger                     log.StructuredLogger
+	metrics                    common.Metrics
+	tracer                     common.Tracer
+	location                   *time.Location
+	keepExtraFields            bool
 }
 
 func (cc *ClientConstructor) Build() *Client {
@@ -59,8 +72,21 @@ func (cc *ClientConstructor) Build() *Client {
 		clientCode:      cc.clientCode,
 		partnerKey:      cc.partnerKey,
 		headersFunc:     cc.headersForEveryRequestFunc,
+		middlewares:     cc.middlewares,
+		retryPolicy:     cc.retryPolicy,
+		rateLimiter:     cc.rateLimiter,
+		quotaTracker:    cc.quotaTracker,
+		bulkConcurrency: cc.bulkConcurrency,
+		logger:          cc.logger,
+		metrics:         cc.metrics,
+		tracer:          cc.tracer,
+		location:        cc.location,
+		keepExtraFields: cc.keepExtraFields,
 	}
 
+	if cli.location == nil {
+		cli.location = time.UTC
+	}
 	if cli.headersFunc == nil {
 		cli.headersFunc = cli.getDefaultMandatoryHeaders
 	}
@@ -104,6 +130,57 @@ func (cc *ClientConstructor) WithSessionProvider(sessProv SessionProvider) {
 	cc.sessionProvider = sessProv
 }
 
+//WithMiddlewares adds middlewares which will wrap every request, the first one is the outermost
+func (cc *ClientConstructor) WithMiddlewares(middlewares ...common.Middleware) {
+	cc.middlewares = append(cc.middlewares, middlewares...)
+}
+
+//WithRetryPolicy enables repeating of failed requests, if it's not set no requests are repeated
+func (cc *ClientConstructor) WithRetryPolicy(retryPolicy *common.RetryPolicy) {
+	cc.retryPolicy = retryPolicy
+}
+
+//WithRateLimiter limits the speed of all requests of the client, share the limiter between clients to give them a common budget
+func (cc *ClientConstructor) WithRateLimiter(rateLimiter common.RateLimiter) {
+	cc.rateLimiter = rateLimiter
+}
+
+//WithQuotaTracker enables counting of requests against the hourly quota of the account
+func (cc *ClientConstructor) WithQuotaTracker(quotaTracker *common.QuotaTracker) {
+	cc.quotaTracker = quotaTracker
+}
+
+//WithBulkConcurrency sets how many bulk requests are sent in parallel when a bulk call is split into
+//multiple requests, by default they are sent one by one
+func (cc *ClientConstructor) WithBulkConcurrency(bulkConcurrency int) {
+	cc.bulkConcurrency = bulkConcurrency
+}
+
+//WithLogger sets the logger for the requests of the client, if it's not set the global log.Log is used
+func (cc *ClientConstructor) WithLogger(logger log.StructuredLogger) {
+	cc.logger = logger
+}
+
+//WithMetrics enables collecting of the request numbers, latencies and throttling waits of the client
+func (cc *ClientConstructor) WithMetrics(metrics common.Metrics) {
+	cc.metrics = metrics
+}
+
+//WithTracer sets the tracer for the requests made with the contexts which don't carry a tracer
+func (cc *ClientConstructor) WithTracer(tracer common.Tracer) {
+	cc.tracer = tracer
+}
+
+//WithLocation sets the time zone of the account, it's UTC by default
+func (cc *ClientConstructor) WithLocation(location *time.Location) {
+	cc.location = location
+}
+
+//WithKeepExtraFields enables keeping the undeclared response fields in the Extra maps of the models which support it
+func (cc *ClientConstructor) WithKeepExtraFields(keepExtraFields bool) {
+	cc.keepExtraFields = keepExtraFields
+}
+
 type SessionProvider interface {
 	GetSession() (sessionKey string, err error)
 	Invalidate()
@@ -128,8 +205,59 @@ type Client struct {
 	partnerKey      string
 	headersFunc     AuthFunc
 	sessionProvider SessionProvider
+	middlewares     []common.Middleware
+	retryPolicy     *common.RetryPolicy
+	rateLimiter     common.RateLimiter
+	quotaTracker    *common.QuotaTracker
+	bulkConcurrency int
+	logger          log.StructuredLogger
+	metrics         common.Metrics
+	tracer          common.Tracer
+	location        *time.Location
+	keepExtraFields bool
 }
 
 func (cli *Client) Close() {
 	cli.httpClient.CloseIdleConnections()
 }
+
+//GetLocation gives the time zone of the account
+func (cli *Client) GetLocation() *time.Location {
+	return cli.location
+}
+
+//GetTracer gives the tracer of the requests made with the contexts which don't carry a tracer
+func (cli *Client) GetTracer() common.Tracer {
+	return cli.tracer
+}
+
+//KeepExtraFields tells if the undeclared response fields are kept in the Extra maps of the models
+func (cli *Client) KeepExtraFields() bool {
+	return cli.keepExtraFields
+}
+
+//DecodeExtraFields fills the Extra maps of the decoded records from the response body if KeepExtraFields is enabled
+func (cli *Client) DecodeExtraFields(body []byte, records interface{}) error {
+	if !cli.keepExtraFields {
+		return nil
+	}
+
+	if err := common.DecodeExtraFields(body, records); err != nil {
+		return common.NewFromError("failed to decode the extra fields", err, 0)
+	}
+
+	return nil
+}
+
+//DecodeBulkExtraFields is DecodeExtraFields for the sub-responses of a bulk response body
+func (cli *Client) DecodeBulkExtraFields(body []byte, records func(i int) interface{}) error {
+	if !cli.keepExtraFields {
+		return nil
+	}
+
+	if err := common.DecodeBulkExtraFields(body, records); err != nil {
+		return common.NewFromError("failed to decode the extra fields", err, 0)
+	}
+
+	return nil
+}
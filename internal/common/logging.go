@@ -0,0 +1,50 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+	"time"
+)
+
+//getLogger gives the logger of the client with the client code and the fields of the context attached,
+//the clients without their own logger write to the global log.Log
+func (cli *Client) getLogger(ctx context.Context) log.StructuredLogger {
+	logger := cli.logger
+	if logger == nil {
+		logger = log.GlobalLogger{}
+	}
+
+	fields := []log.Field{log.F(log.FieldClientCode, cli.getClientCode())}
+	fields = append(fields, log.FieldsFromContext(ctx)...)
+
+	return log.With(logger, fields...)
+}
+
+//logRequest writes a summary of a finished request, failed requests and API errors are logged as warnings
+func (cli *Client) logRequest(ctx context.Context, req *common.Request, started time.Time, resp *common.Response, err error) {
+	fields := []log.Field{
+		log.F(log.FieldMethod, getRequestName(req)),
+		log.F(log.FieldDuration, time.Since(started)),
+	}
+
+	level := log.Debug
+	if resp != nil && resp.HTTPResponse != nil {
+		fields = append(fields, log.F(log.FieldStatus, resp.HTTPResponse.StatusCode))
+	}
+	if resp != nil && resp.Status != nil && resp.Status.ErrorCode != 0 {
+		fields = append(fields, log.F(log.FieldErrorCode, int(resp.Status.ErrorCode)))
+		level = log.Warn
+	}
+	if err != nil {
+		var erplyErr *common.ErplyError
+		if errors.As(err, &erplyErr) && erplyErr.Code != 0 {
+			fields = append(fields, log.F(log.FieldErrorCode, int(erplyErr.Code)))
+		}
+		fields = append(fields, log.F(log.FieldError, err.Error()))
+		level = log.Warn
+	}
+
+	cli.getLogger(ctx).LogFields(level, "request finished", fields...)
+}
@@ -0,0 +1,113 @@
+package common
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+type logEntry struct {
+	level   log.Type
+	message string
+	fields  map[string]interface{}
+}
+
+type recordingLogger struct {
+	lock    sync.Mutex
+	entries []logEntry
+}
+
+func (rl *recordingLogger) LogFields(t log.Type, message string, fields ...log.Field) {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+
+	fieldsMap := map[string]interface{}{}
+	for _, field := range fields {
+		fieldsMap[field.Key] = field.Value
+	}
+	rl.entries = append(rl.entries, logEntry{level: t, message: message, fields: fieldsMap})
+}
+
+func (rl *recordingLogger) finished() []logEntry {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+
+	var entries []logEntry
+	for _, entry := range rl.entries {
+		if entry.message == "request finished" {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+func TestRequestLogging(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("request") == "getProducts" {
+			_, _ = w.Write([]byte(`{"status":{"request":"getProducts","responseStatus":"ok","errorCode":0},"records":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":{"request":"getCustomers","responseStatus":"error","errorCode":1002},"records":[]}`))
+	}))
+	defer srv.Close()
+
+	logger := &recordingLogger{}
+	constr := &ClientConstructor{}
+	constr.WithSessionKey("somesess")
+	constr.WithClientCode("someclient")
+	constr.WithURL(srv.URL)
+	constr.WithLogger(logger)
+	cli := constr.Build()
+
+	ctx := log.ContextWithFields(context.Background(), log.F("requestID", "abc"))
+	assert.NoError(t, cli.Scan(ctx, "getProducts", map[string]string{}, &statusResponse{}))
+	assert.Error(t, cli.Scan(ctx, "getCustomers", map[string]string{}, &statusResponse{}))
+
+	entries := logger.finished()
+	if !assert.Len(t, entries, 2) {
+		return
+	}
+
+	assert.Equal(t, log.Debug, entries[0].level)
+	assert.Equal(t, "someclient", entries[0].fields[log.FieldClientCode])
+	assert.Equal(t, "getProducts", entries[0].fields[log.FieldMethod])
+	assert.Equal(t, http.StatusOK, entries[0].fields[log.FieldStatus])
+	assert.Equal(t, "abc", entries[0].fields["requestID"])
+	assert.Contains(t, entries[0].fields, log.FieldDuration)
+	assert.NotContains(t, entries[0].fields, log.FieldErrorCode)
+
+	assert.Equal(t, log.Warn, entries[1].level)
+	assert.Equal(t, "getCustomers", entries[1].fields[log.FieldMethod])
+	assert.Equal(t, 1002, entries[1].fields[log.FieldErrorCode])
+}
+
+type recordingGlobalLogger struct {
+	messages []string
+}
+
+func (rl *recordingGlobalLogger) Log(t log.Type, message string, arguments ...interface{}) {
+	rl.messages = append(rl.messages, message)
+}
+
+func TestRequestLoggingFallsBackToGlobalLog(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":{"request":"getProducts","responseStatus":"ok"},"records":[]}`))
+	}))
+	defer srv.Close()
+
+	globalLogger := &recordingGlobalLogger{}
+	oldLogger := log.Log
+	log.Log = globalLogger
+	defer func() {
+		log.Log = oldLogger
+	}()
+
+	cli := NewClientWithURL("somesess", "someclient", "", srv.URL, nil, nil)
+	assert.NoError(t, cli.Scan(context.Background(), "getProducts", map[string]string{}, &statusResponse{}))
+	assert.NotEmpty(t, globalLogger.messages)
+}
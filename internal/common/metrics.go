@@ -0,0 +1,69 @@
+package common
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"time"
+)
+
+//minThrottleWait is the shortest wait for the rate limiter or the quota tracker which is reported to the metrics
+const minThrottleWait = time.Millisecond
+
+//measure reports each HTTP call to the metrics of the client
+func (cli *Client) measure(next common.RequestHandler) common.RequestHandler {
+	return func(ctx context.Context, req *common.Request) (*common.Response, error) {
+		if cli.metrics == nil {
+			return next(ctx, req)
+		}
+
+		started := time.Now()
+		resp, err := next(ctx, req)
+
+		sample := common.RequestSample{
+			ClientCode: cli.getClientCode(),
+			Method:     req.Method,
+			Failed:     err != nil,
+			Duration:   time.Since(started),
+		}
+		if req.IsBulk() {
+			sample.Method = common.BulkRequestMethod
+		}
+		if resp != nil {
+			if resp.HTTPResponse != nil {
+				sample.HTTPStatus = resp.HTTPResponse.StatusCode
+			}
+			if resp.Status != nil {
+				sample.ErrorCode = resp.Status.ErrorCode
+			}
+		}
+		for i, input := range req.BulkInputs {
+			subRequest := common.SubRequestSample{Method: input.MethodName}
+			if resp != nil && i < len(resp.BulkStatuses) {
+				subRequest.ErrorCode = resp.BulkStatuses[i].ErrorCode
+			}
+			sample.SubRequests = append(sample.SubRequests, subRequest)
+		}
+
+		cli.metrics.ObserveRequest(sample)
+
+		return resp, err
+	}
+}
+
+//observeThrottleWait reports the time spent waiting for the throttle source if it's noticeable
+func (cli *Client) observeThrottleWait(source common.ThrottleSource, started time.Time) {
+	if cli.metrics == nil {
+		return
+	}
+
+	wait := time.Since(started)
+	if wait < minThrottleWait {
+		return
+	}
+	cli.metrics.ObserveThrottleWait(cli.getClientCode(), source, wait)
+}
+
+//GetMetrics gives the metrics of the client or nil if they are not collected
+func (cli *Client) GetMetrics() common.Metrics {
+	return cli.metrics
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type slowRateLimiter struct{}
+
+func (srl slowRateLimiter) Wait(ctx context.Context) error {
+	time.Sleep(2 * time.Millisecond)
+	return nil
+}
+
+func TestMetricsAreCollected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("request") == "getProducts" {
+			_, _ = w.Write([]byte(`{"status":{"request":"getProducts","responseStatus":"error","errorCode":1002},"records":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{
+			"status":{"responseStatus":"ok"},
+			"requests":[
+				{"status":{"requestName":"getCustomers","responseStatus":"ok","errorCode":0}},
+				{"status":{"requestName":"saveCustomer","responseStatus":"error","errorCode":1010}}
+			]
+		}`))
+	}))
+	defer srv.Close()
+
+	metrics := common.NewInMemoryMetrics()
+	constr := &ClientConstructor{}
+	constr.WithSessionKey("somesess")
+	constr.WithClientCode("someclient")
+	constr.WithURL(srv.URL)
+	constr.WithRateLimiter(slowRateLimiter{})
+	constr.WithMetrics(metrics)
+	cli := constr.Build()
+	assert.Equal(t, metrics, cli.GetMetrics())
+
+	_, err := cli.SendRequest(context.Background(), "getProducts", map[string]string{})
+	assert.NoError(t, err)
+	_, err = cli.SendRequestBulk(context.Background(), []BulkInput{
+		{MethodName: "getCustomers", Filters: map[string]interface{}{}},
+		{MethodName: "saveCustomer", Filters: map[string]interface{}{}},
+	}, map[string]string{})
+	assert.NoError(t, err)
+
+	snapshot := metrics.Snapshot()
+
+	productStats := snapshot.Requests["getProducts"]
+	if assert.NotNil(t, productStats) {
+		assert.Equal(t, int64(1), productStats.Count)
+		assert.Equal(t, map[int]int64{http.StatusOK: 1}, productStats.ByStatus)
+		assert.Equal(t, map[common.ApiError]int64{common.HourlyRequestQuota: 1}, productStats.ByErrorCode)
+		assert.Equal(t, int64(1), productStats.Latency.Count)
+	}
+
+	bulkStats := snapshot.Requests[common.BulkRequestMethod]
+	if assert.NotNil(t, bulkStats) {
+		assert.Equal(t, int64(1), bulkStats.Count)
+	}
+	assert.Equal(t, map[string]*common.SubRequestStats{
+		"getCustomers": {Count: 1, ByErrorCode: map[common.ApiError]int64{}},
+		"saveCustomer": {Count: 1, ByErrorCode: map[common.ApiError]int64{common.RequiredParamMissing: 1}},
+	}, snapshot.BulkSubRequests)
+
+	waitStats := snapshot.ThrottleWaits[common.ThrottleRateLimiter]
+	if assert.NotNil(t, waitStats) {
+		assert.Equal(t, int64(2), waitStats.Count)
+	}
+}
+
+func TestMetricsOfFailedRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.Close()
+
+	metrics := common.NewInMemoryMetrics()
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithMetrics(metrics)
+	cli := constr.Build()
+
+	_, err := cli.SendRequest(context.Background(), "getProducts", map[string]string{})
+	assert.Error(t, err)
+
+	stats := metrics.Snapshot().Requests["getProducts"]
+	if assert.NotNil(t, stats) {
+		assert.Equal(t, int64(1), stats.Count)
+		assert.Equal(t, int64(1), stats.Failures)
+		assert.Empty(t, stats.ByStatus)
+	}
+}
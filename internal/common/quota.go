@@ -0,0 +1,56 @@
+package common
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+	"time"
+)
+
+//trackQuota counts the requests in the quota tracker of the client and pauses requests once the hourly quota is exhausted
+func (cli *Client) trackQuota(next common.RequestHandler) common.RequestHandler {
+	return func(ctx context.Context, req *common.Request) (*common.Response, error) {
+		if cli.quotaTracker == nil {
+			return next(ctx, req)
+		}
+
+		clientCode := cli.getClientCode()
+		started := time.Now()
+		err := cli.quotaTracker.Acquire(ctx, clientCode, cli.quotaTracker.RequestCost(req))
+		cli.observeThrottleWait(common.ThrottleQuotaTracker, started)
+		if err != nil {
+			return nil, common.NewFromError(getRequestName(req)+" request was not sent because of the hourly quota", err, common.HourlyRequestQuota)
+		}
+
+		resp, err := next(ctx, req)
+		if err == nil && resp.Status != nil && resp.Status.ErrorCode == common.HourlyRequestQuota {
+			cli.getLogger(ctx).LogFields(log.Warn, "hourly request quota is exhausted, will pause requests till the next hour")
+			cli.quotaTracker.MarkExhausted(clientCode)
+		}
+
+		return resp, err
+	}
+}
+
+//GetQuotaTracker gives the quota tracker of the client or nil if the quota is not tracked
+func (cli *Client) GetQuotaTracker() *common.QuotaTracker {
+	return cli.quotaTracker
+}
+
+//GetRemainingQuota gives the amount of requests which can be made in the current hour,
+//it's -1 if the quota is not tracked or the hourly limit is unknown
+func (cli *Client) GetRemainingQuota() int {
+	if cli.quotaTracker == nil {
+		return -1
+	}
+
+	return cli.quotaTracker.Remaining(cli.getClientCode())
+}
+
+func (cli *Client) getClientCode() string {
+	if cli.clientCode != "" || cli.headersFunc == nil {
+		return cli.clientCode
+	}
+
+	return cli.headersFunc("").Get(clientCode)
+}
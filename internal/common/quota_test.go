@@ -0,0 +1,65 @@
+package common
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestQuotaTracking(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"status":{"responseStatus":"ok"}}`))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithClientCode("123")
+	constr.WithQuotaTracker(common.NewQuotaTracker(common.QuotaSettings{HourlyLimit: 100}))
+	cli := constr.Build()
+
+	_, err := cli.SendRequest(context.Background(), "getProducts", map[string]string{})
+	assert.NoError(t, err)
+
+	_, err = cli.SendRequestBulk(
+		context.Background(),
+		[]BulkInput{
+			{MethodName: "getProducts", Filters: map[string]interface{}{}},
+			{MethodName: "getProducts", Filters: map[string]interface{}{}},
+		},
+		map[string]string{},
+	)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, cli.GetQuotaTracker().Used("123"))
+	assert.Equal(t, 97, cli.GetRemainingQuota())
+}
+
+func TestQuotaExhaustion(t *testing.T) {
+	calledTimes := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calledTimes++
+		_, err := w.Write([]byte(`{"status":{"responseStatus":"error","errorCode":1002}}`))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithClientCode("123")
+	constr.WithQuotaTracker(common.NewQuotaTracker(common.QuotaSettings{RejectInsteadOfWaiting: true}))
+	cli := constr.Build()
+
+	_, err := cli.SendRequest(context.Background(), "getProducts", map[string]string{})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, cli.GetRemainingQuota())
+
+	_, err = cli.SendRequest(context.Background(), "getProducts", map[string]string{})
+	assert.Error(t, err)
+	assert.Equal(t, common.HourlyRequestQuota, err.(*common.ErplyError).Code)
+	assert.Equal(t, 1, calledTimes)
+}
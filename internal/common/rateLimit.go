@@ -0,0 +1,30 @@
+package common
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"time"
+)
+
+//limitRate waits for the rate limiter of the client before each HTTP call
+func (cli *Client) limitRate(next common.RequestHandler) common.RequestHandler {
+	return func(ctx context.Context, req *common.Request) (*common.Response, error) {
+		if cli.rateLimiter == nil {
+			return next(ctx, req)
+		}
+
+		started := time.Now()
+		err := cli.rateLimiter.Wait(ctx)
+		cli.observeThrottleWait(common.ThrottleRateLimiter, started)
+		if err != nil {
+			return nil, common.NewFromError(getRequestName(req)+" request was not sent while waiting for the rate limiter", err, 0)
+		}
+
+		return next(ctx, req)
+	}
+}
+
+//GetRateLimiter gives the rate limiter of the client or nil if requests are not limited
+func (cli *Client) GetRateLimiter() common.RateLimiter {
+	return cli.rateLimiter
+}
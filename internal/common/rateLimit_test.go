@@ -0,0 +1,64 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type rateLimiterMock struct {
+	waitsCount int
+	errToGive  error
+}
+
+func (rlm *rateLimiterMock) Wait(ctx context.Context) error {
+	rlm.waitsCount++
+	return rlm.errToGive
+}
+
+func TestRateLimiterIsAppliedToAllRequests(t *testing.T) {
+	calledTimes := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calledTimes++
+	}))
+	defer srv.Close()
+
+	limiter := &rateLimiterMock{}
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithRateLimiter(limiter)
+	cli := constr.Build()
+
+	_, err := cli.SendRequest(context.Background(), "getProducts", map[string]string{})
+	assert.NoError(t, err)
+
+	_, err = cli.SendRequestBulk(context.Background(), []BulkInput{{MethodName: "getProducts", Filters: map[string]interface{}{}}}, map[string]string{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, limiter.waitsCount)
+	assert.Equal(t, 2, calledTimes)
+	assert.Equal(t, limiter, cli.GetRateLimiter())
+}
+
+func TestRateLimiterFailure(t *testing.T) {
+	calledTimes := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calledTimes++
+	}))
+	defer srv.Close()
+
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithRateLimiter(&rateLimiterMock{errToGive: errors.New("some limiter error")})
+	cli := constr.Build()
+
+	_, err := cli.SendRequest(context.Background(), "getProducts", map[string]string{})
+	assert.EqualError(t, err, "ERPLY API: getProducts request was not sent while waiting for the rate limiter: some limiter error, status: Error, code: 0")
+	assert.Equal(t, 0, calledTimes)
+
+	var _ common.RateLimiter = common.NewTokenBucketLimiter(common.RateLimits{})
+}
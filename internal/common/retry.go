@@ -0,0 +1,115 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+	"time"
+)
+
+//retry repeats failed requests according to the retry policy of the client, only the retryable sub-requests
+//of a bulk call are repeated if the call itself succeeded
+func (cli *Client) retry(next common.RequestHandler) common.RequestHandler {
+	return func(ctx context.Context, req *common.Request) (*common.Response, error) {
+		if cli.retryPolicy == nil {
+			return next(ctx, req)
+		}
+
+		resp, err := next(ctx, req)
+		for attempt := 1; attempt < cli.retryPolicy.MaxAttempts; attempt++ {
+			var bulkItems []int
+			if err == nil {
+				bulkItems = cli.retryPolicy.RetryableBulkItems(req, resp)
+			}
+			if len(bulkItems) == 0 && !cli.retryPolicy.ShouldRetry(req, resp, err) {
+				break
+			}
+
+			backoff := cli.retryPolicy.Backoff(attempt)
+			cli.getLogger(ctx).LogFields(
+				log.Debug,
+				fmt.Sprintf("will retry after %v, attempt %d of %d failed", backoff, attempt, cli.retryPolicy.MaxAttempts),
+				log.F(log.FieldMethod, getRequestName(req)),
+			)
+
+			if err := wait(ctx, backoff); err != nil {
+				return nil, common.NewFromError("waiting for the next attempt was interrupted", err, 0)
+			}
+
+			if len(bulkItems) > 0 {
+				resp = retryBulkItems(ctx, next, req, resp, bulkItems)
+				continue
+			}
+			resp, err = next(ctx, req)
+		}
+
+		return resp, err
+	}
+}
+
+//retryBulkItems repeats the sub-requests with the given indexes and puts their results into the bulk response,
+//the response is kept as it is if the repeated call fails
+func retryBulkItems(ctx context.Context, next common.RequestHandler, req *common.Request, resp *common.Response, indexes []int) *common.Response {
+	retryReq := &common.Request{
+		Filters:    req.Filters,
+		BulkInputs: make([]BulkInput, 0, len(indexes)),
+		Header:     req.Header,
+	}
+	for _, i := range indexes {
+		retryReq.BulkInputs = append(retryReq.BulkInputs, req.BulkInputs[i])
+	}
+
+	retryResp, err := next(ctx, retryReq)
+	if err != nil || retryResp == nil || retryResp.Status == nil || !IsJSONResponseOK(retryResp.Status) {
+		return resp
+	}
+
+	var envelope, retryEnvelope bulkResponseEnvelope
+	if json.Unmarshal(resp.Body, &envelope) != nil || json.Unmarshal(retryResp.Body, &retryEnvelope) != nil {
+		return resp
+	}
+	if len(retryEnvelope.Requests) != len(indexes) {
+		return resp
+	}
+
+	for retryIndex, i := range indexes {
+		if i < len(envelope.Requests) {
+			envelope.Requests[i] = retryEnvelope.Requests[retryIndex]
+		}
+	}
+
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		return resp
+	}
+
+	mergedResp := &common.Response{
+		HTTPResponse: resp.HTTPResponse,
+		Body:         body,
+	}
+	mergedResp.Status, mergedResp.BulkStatuses = common.DecodeStatuses(body)
+
+	return mergedResp
+}
+
+func getRequestName(req *common.Request) string {
+	if req.IsBulk() {
+		return "Bulk request"
+	}
+
+	return req.Method
+}
+
+func wait(ctx context.Context, dur time.Duration) error {
+	timer := time.NewTimer(dur)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,235 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newRetryTestServer(t *testing.T, statusCodes ...common.ApiError) (*httptest.Server, *int) {
+	calledTimes := 0
+	lock := sync.Mutex{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		status := common.Status{ResponseStatus: "ok"}
+		if calledTimes < len(statusCodes) {
+			status = common.Status{ResponseStatus: "error", ErrorCode: statusCodes[calledTimes]}
+		}
+		calledTimes++
+
+		jsonRaw, err := json.Marshal(map[string]interface{}{"status": status})
+		assert.NoError(t, err)
+
+		_, err = w.Write(jsonRaw)
+		assert.NoError(t, err)
+	}))
+
+	return srv, &calledTimes
+}
+
+func newRetryTestClient(url string, maxAttempts int) *Client {
+	constr := &ClientConstructor{}
+	constr.WithURL(url)
+	constr.WithRetryPolicy(&common.RetryPolicy{
+		MaxAttempts:    maxAttempts,
+		InitialBackoff: time.Millisecond,
+	})
+
+	return constr.Build()
+}
+
+func TestRetrySuccess(t *testing.T) {
+	srv, calledTimes := newRetryTestServer(t, common.ServerMaintenance, common.DbError)
+	defer srv.Close()
+
+	cli := newRetryTestClient(srv.URL, 3)
+
+	resp, err := cli.SendRequest(context.Background(), "getProducts", map[string]string{})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+	assert.Equal(t, 3, *calledTimes)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"responseStatus":"ok"`)
+}
+
+func TestRetryAttemptsExhausted(t *testing.T) {
+	srv, calledTimes := newRetryTestServer(t, common.DbError, common.DbError, common.DbError)
+	defer srv.Close()
+
+	cli := newRetryTestClient(srv.URL, 2)
+
+	resp, err := cli.SendRequest(context.Background(), "getProducts", map[string]string{})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+	assert.Equal(t, 2, *calledTimes)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"errorCode":1008`)
+}
+
+func TestRetryNonIdempotentRequest(t *testing.T) {
+	srv, calledTimes := newRetryTestServer(t, common.DbError)
+	defer srv.Close()
+
+	cli := newRetryTestClient(srv.URL, 3)
+
+	_, err := cli.SendRequestBulk(
+		context.Background(),
+		[]BulkInput{{MethodName: "saveProduct", Filters: map[string]interface{}{"name": "some name"}}},
+		map[string]string{},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *calledTimes)
+}
+
+func TestRetryContextCancellation(t *testing.T) {
+	srv, calledTimes := newRetryTestServer(t, common.ServerMaintenance)
+	defer srv.Close()
+
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithRetryPolicy(&common.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Hour,
+	})
+	cli := constr.Build()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := cli.SendRequest(ctx, "getProducts", map[string]string{})
+	assert.Error(t, err)
+	assert.Equal(t, 1, *calledTimes)
+}
+
+func TestRetryBulkItems(t *testing.T) {
+	lock := sync.Mutex{}
+	received := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		var requests []map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(r.FormValue("requests")), &requests))
+
+		bulkItems := make([]interface{}, 0, len(requests))
+		for _, request := range requests {
+			id := request["id"].(string)
+			status := common.StatusBulk{RequestID: id}
+			status.ResponseStatus = "ok"
+			//the get request with id 2 fails once, the save request always fails
+			if (id == "2" && !contains(received, id)) || request["requestName"] == "saveProduct" {
+				status.ResponseStatus = "error"
+				status.ErrorCode = common.DbError
+			}
+			received = append(received, id)
+			bulkItems = append(bulkItems, map[string]interface{}{
+				"status":  status,
+				"records": []interface{}{map[string]interface{}{"id": id}},
+			})
+		}
+
+		jsonRaw, err := json.Marshal(map[string]interface{}{
+			"status":   common.Status{ResponseStatus: "ok"},
+			"requests": bulkItems,
+		})
+		assert.NoError(t, err)
+
+		_, err = w.Write(jsonRaw)
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	cli := newRetryTestClient(srv.URL, 3)
+
+	bulkResp, err := cli.ScanBulk(context.Background(), []BulkInput{
+		{MethodName: "getProducts", Filters: map[string]interface{}{"id": "1"}},
+		{MethodName: "getProducts", Filters: map[string]interface{}{"id": "2"}},
+		{MethodName: "saveProduct", Filters: map[string]interface{}{"id": "3"}},
+	}, map[string]string{})
+
+	bulkErr := &common.BulkError{}
+	if assert.True(t, errors.As(err, &bulkErr)) {
+		assert.Equal(t, []int{2}, bulkErr.FailedIndexes())
+	}
+	assert.Equal(t, []string{"1", "2", "3", "2"}, received)
+
+	if assert.Len(t, bulkResp.BulkItems, 3) {
+		for i, bulkItem := range bulkResp.BulkItems {
+			assert.Equal(t, fmt.Sprint(i+1), bulkItem.Status.RequestID)
+		}
+		assert.Equal(t, "ok", bulkResp.BulkItems[1].Status.ResponseStatus)
+	}
+}
+
+func TestRetryBulkItemsWithCurrentInvoice(t *testing.T) {
+	received := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requests []map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(r.FormValue("requests")), &requests))
+
+		bulkItems := make([]interface{}, 0, len(requests))
+		for _, request := range requests {
+			id := request["id"].(string)
+			status := common.StatusBulk{RequestID: id}
+			status.ResponseStatus = "ok"
+			if request["requestName"] == "savePayment" {
+				status.ResponseStatus = "error"
+				status.ErrorCode = common.ServerMaintenance
+			}
+			received = append(received, id)
+			bulkItems = append(bulkItems, map[string]interface{}{"status": status, "records": []interface{}{}})
+		}
+
+		jsonRaw, err := json.Marshal(map[string]interface{}{
+			"status":   common.Status{ResponseStatus: "ok"},
+			"requests": bulkItems,
+		})
+		assert.NoError(t, err)
+
+		_, err = w.Write(jsonRaw)
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	cli := newRetryTestClient(srv.URL, 3)
+
+	_, err := cli.ScanBulk(context.Background(), []BulkInput{
+		{MethodName: "saveSalesDocument", Filters: map[string]interface{}{"id": "1"}},
+		{MethodName: "savePayment", Filters: map[string]interface{}{"id": "2", "documentID": common.CurrentInvoiceID}},
+	}, map[string]string{})
+
+	bulkErr := &common.BulkError{}
+	if assert.True(t, errors.As(err, &bulkErr)) {
+		assert.Equal(t, []int{1}, bulkErr.FailedIndexes())
+	}
+	assert.Equal(t, []string{"1", "2"}, received)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,67 @@
+package common
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/log"
+)
+
+//renewSession replays the request once with a fresh session key if the API reports an expired or invalid session
+func (cli *Client) renewSession(next common.RequestHandler) common.RequestHandler {
+	return func(ctx context.Context, req *common.Request) (*common.Response, error) {
+		resp, err := next(ctx, req)
+		if err != nil || !isSessionExpired(resp) {
+			return resp, err
+		}
+
+		cli.getLogger(ctx).LogFields(
+			log.Debug,
+			"request failed because of an expired session, will renew the session",
+			log.F(log.FieldMethod, getRequestName(req)),
+		)
+
+		currentSessionKey, _ := cli.sessionProvider.GetSession()
+		if currentSessionKey == req.SessionKey {
+			cli.sessionProvider.Invalidate()
+		}
+
+		newSessionKey, err := cli.sessionProvider.GetSession()
+		if err != nil {
+			return nil, common.NewFromError("failed to renew the expired session", err, resp.Status.ErrorCode)
+		}
+		if newSessionKey == "" {
+			cli.getLogger(ctx).LogFields(
+				log.Debug,
+				"session provider cannot give a new session key, will not repeat the request",
+				log.F(log.FieldMethod, getRequestName(req)),
+			)
+			return resp, nil
+		}
+
+		return next(ctx, req)
+	}
+}
+
+func isSessionExpired(resp *common.Response) bool {
+	if resp.Status == nil {
+		return false
+	}
+	if isSessionErrorCode(resp.Status.ErrorCode) {
+		return true
+	}
+	if len(resp.BulkStatuses) == 0 {
+		return false
+	}
+
+	for _, bulkStatus := range resp.BulkStatuses {
+		if !isSessionErrorCode(bulkStatus.ErrorCode) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isSessionErrorCode(code common.ApiError) bool {
+	return code == common.APISessionExpired || code == common.InvalidSession
+}
@@ -0,0 +1,169 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+type sessionProviderMock struct {
+	lock             sync.Mutex
+	sessionsCount    int
+	invalidatesCount int
+	getSessionCalls  int
+	sessionKey       string
+}
+
+func (spm *sessionProviderMock) GetSession() (sessionKey string, err error) {
+	spm.lock.Lock()
+	defer spm.lock.Unlock()
+
+	spm.getSessionCalls++
+	if spm.sessionKey == "" {
+		spm.sessionsCount++
+		spm.sessionKey = "key" + strconv.Itoa(spm.sessionsCount)
+	}
+
+	return spm.sessionKey, nil
+}
+
+func (spm *sessionProviderMock) Invalidate() {
+	spm.lock.Lock()
+	defer spm.lock.Unlock()
+
+	spm.invalidatesCount++
+	spm.sessionKey = ""
+}
+
+type scanDestMock struct {
+	Status common.Status `json:"status"`
+}
+
+func (sdm *scanDestMock) GetStatus() *common.Status {
+	return &sdm.Status
+}
+
+func newSessionTestServer(t *testing.T, validSessionKey string, isBulk bool) (*httptest.Server, *[]string) {
+	usedKeys := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sessionKey := r.FormValue("sessionKey")
+		usedKeys = append(usedKeys, sessionKey)
+
+		status := common.Status{ResponseStatus: "ok"}
+		if sessionKey != validSessionKey {
+			status = common.Status{ResponseStatus: "error", ErrorCode: common.APISessionExpired}
+		}
+
+		resp := map[string]interface{}{"status": status}
+		if isBulk {
+			bulkStatus := common.StatusBulk{Status: status}
+			resp = map[string]interface{}{
+				"status":   common.Status{ResponseStatus: "ok"},
+				"requests": []interface{}{map[string]interface{}{"status": bulkStatus}},
+			}
+		}
+
+		jsonRaw, err := json.Marshal(resp)
+		assert.NoError(t, err)
+
+		_, err = w.Write(jsonRaw)
+		assert.NoError(t, err)
+	}))
+
+	return srv, &usedKeys
+}
+
+func TestSessionRenewal(t *testing.T) {
+	srv, usedKeys := newSessionTestServer(t, "key2", false)
+	defer srv.Close()
+
+	sessProvider := &sessionProviderMock{}
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithSessionProvider(sessProvider)
+	cli := constr.Build()
+
+	dest := &scanDestMock{}
+	err := cli.Scan(context.Background(), "getProducts", map[string]string{}, dest)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"key1", "key2"}, *usedKeys)
+	assert.Equal(t, 1, sessProvider.invalidatesCount)
+}
+
+func TestSessionIsRequestedOncePerRequest(t *testing.T) {
+	srv, usedKeys := newSessionTestServer(t, "key1", false)
+	defer srv.Close()
+
+	sessProvider := &sessionProviderMock{}
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithSessionProvider(sessProvider)
+	cli := constr.Build()
+
+	dest := &scanDestMock{}
+	err := cli.Scan(context.Background(), "getProducts", map[string]string{}, dest)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"key1"}, *usedKeys)
+	assert.Equal(t, 1, sessProvider.getSessionCalls)
+	assert.Equal(t, 0, sessProvider.invalidatesCount)
+}
+
+func TestSessionRenewalBulk(t *testing.T) {
+	srv, usedKeys := newSessionTestServer(t, "key2", true)
+	defer srv.Close()
+
+	sessProvider := &sessionProviderMock{}
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithSessionProvider(sessProvider)
+	cli := constr.Build()
+
+	_, err := cli.SendRequestBulk(
+		context.Background(),
+		[]BulkInput{{MethodName: "getProducts", Filters: map[string]interface{}{}}},
+		map[string]string{},
+	)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"key1", "key2"}, *usedKeys)
+	assert.Equal(t, 1, sessProvider.invalidatesCount)
+}
+
+func TestSessionRenewalIsDoneOnce(t *testing.T) {
+	srv, usedKeys := newSessionTestServer(t, "someKeyWhichIsNeverGiven", false)
+	defer srv.Close()
+
+	sessProvider := &sessionProviderMock{}
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithSessionProvider(sessProvider)
+	cli := constr.Build()
+
+	dest := &scanDestMock{}
+	err := cli.Scan(context.Background(), "getProducts", map[string]string{}, dest)
+	assert.Error(t, err)
+	assert.Equal(t, common.APISessionExpired, dest.Status.ErrorCode)
+
+	assert.Equal(t, []string{"key1", "key2"}, *usedKeys)
+}
+
+func TestSessionRenewalWithStaticSessionKey(t *testing.T) {
+	srv, usedKeys := newSessionTestServer(t, "someKeyWhichIsNeverGiven", false)
+	defer srv.Close()
+
+	cli := NewClientWithURL("staticKey", "someclient", "", srv.URL, nil, nil)
+
+	dest := &scanDestMock{}
+	err := cli.Scan(context.Background(), "getProducts", map[string]string{}, dest)
+	assert.Error(t, err)
+
+	assert.Equal(t, []string{"staticKey"}, *usedKeys)
+}
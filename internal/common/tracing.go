@@ -0,0 +1,75 @@
+package common
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//withTracer adds the tracer of the client to the context unless the context already has one
+func (cli *Client) withTracer(ctx context.Context) context.Context {
+	if cli.tracer == nil || common.TracerFromContext(ctx) != nil {
+		return ctx
+	}
+
+	return common.ContextWithTracer(ctx, cli.tracer)
+}
+
+//trace reports each HTTP call and the bulk sub-requests in it as spans to the tracer of the context
+func (cli *Client) trace(next common.RequestHandler) common.RequestHandler {
+	return func(ctx context.Context, req *common.Request) (*common.Response, error) {
+		if common.TracerFromContext(ctx) == nil {
+			return next(ctx, req)
+		}
+
+		method := req.Method
+		if req.IsBulk() {
+			method = common.BulkRequestMethod
+		}
+		attributes := []common.Attribute{
+			common.Attr(common.AttrClientCode, cli.getClientCode()),
+			common.Attr(common.AttrMethod, method),
+		}
+		if req.IsBulk() {
+			attributes = append(attributes, common.Attr(common.AttrBulkSize, len(req.BulkInputs)))
+		}
+
+		spanCtx, span := common.StartSpan(ctx, common.SpanHTTPCall, attributes...)
+		resp, err := next(spanCtx, req)
+
+		if resp != nil {
+			if resp.HTTPResponse != nil {
+				span.SetAttributes(common.Attr(common.AttrHTTPStatus, resp.HTTPResponse.StatusCode))
+			}
+			if resp.Status != nil {
+				span.SetAttributes(
+					common.Attr(common.AttrErrorCode, int(resp.Status.ErrorCode)),
+					common.Attr(common.AttrGenerationTime, resp.Status.GenerationTime),
+				)
+			}
+			traceBulkSubRequests(spanCtx, req, resp)
+		}
+		span.End(err)
+
+		return resp, err
+	}
+}
+
+//traceBulkSubRequests gives a span for each sub-request of the bulk request, as the API doesn't tell when
+//the sub-requests were processed, the spans only carry their results and the generation time
+func traceBulkSubRequests(ctx context.Context, req *common.Request, resp *common.Response) {
+	for i, input := range req.BulkInputs {
+		_, span := common.StartSpan(
+			ctx,
+			common.SpanBulkSubRequest,
+			common.Attr(common.AttrMethod, input.MethodName),
+			common.Attr(common.AttrSubRequestIndex, i),
+		)
+		if i < len(resp.BulkStatuses) {
+			span.SetAttributes(
+				common.Attr(common.AttrErrorCode, int(resp.BulkStatuses[i].ErrorCode)),
+				common.Attr(common.AttrGenerationTime, resp.BulkStatuses[i].GenerationTime),
+			)
+		}
+		span.End(nil)
+	}
+}
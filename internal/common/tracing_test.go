@@ -0,0 +1,131 @@
+package common
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+type spanMock struct {
+	name       string
+	parent     *spanMock
+	attributes map[string]interface{}
+	ended      bool
+}
+
+func (sm *spanMock) SetAttributes(attributes ...common.Attribute) {
+	for _, attribute := range attributes {
+		sm.attributes[attribute.Key] = attribute.Value
+	}
+}
+
+func (sm *spanMock) End(err error) {
+	sm.ended = true
+}
+
+type spanCtxKey struct{}
+
+type tracerMock struct {
+	lock  sync.Mutex
+	spans []*spanMock
+}
+
+func (tm *tracerMock) StartSpan(ctx context.Context, name string, attributes ...common.Attribute) (context.Context, common.Span) {
+	tm.lock.Lock()
+	defer tm.lock.Unlock()
+
+	parent, _ := ctx.Value(spanCtxKey{}).(*spanMock)
+	span := &spanMock{name: name, parent: parent, attributes: map[string]interface{}{}}
+	span.SetAttributes(attributes...)
+	tm.spans = append(tm.spans, span)
+
+	return context.WithValue(ctx, spanCtxKey{}, span), span
+}
+
+func TestBulkRequestSpans(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"status":{"responseStatus":"ok","generationTime":0.5},
+			"requests":[
+				{"status":{"requestName":"getCustomers","responseStatus":"ok","generationTime":0.1}},
+				{"status":{"requestName":"saveCustomer","responseStatus":"error","errorCode":1010,"generationTime":0.4}}
+			]
+		}`))
+	}))
+	defer srv.Close()
+
+	tracer := &tracerMock{}
+	constr := &ClientConstructor{}
+	constr.WithSessionKey("somesess")
+	constr.WithClientCode("someclient")
+	constr.WithURL(srv.URL)
+	constr.WithHeaderFunc(func(requestName string) url.Values {
+		return url.Values{"clientCode": {"someclient"}}
+	})
+	constr.WithTracer(tracer)
+	cli := constr.Build()
+
+	_, err := cli.SendRequestBulk(context.Background(), []BulkInput{
+		{MethodName: "getCustomers", Filters: map[string]interface{}{}},
+		{MethodName: "saveCustomer", Filters: map[string]interface{}{}},
+	}, map[string]string{})
+	assert.NoError(t, err)
+
+	if !assert.Len(t, tracer.spans, 4) {
+		return
+	}
+
+	httpSpan := tracer.spans[0]
+	assert.Equal(t, common.SpanHTTPCall, httpSpan.name)
+	assert.Nil(t, httpSpan.parent)
+	assert.True(t, httpSpan.ended)
+	assert.Equal(t, map[string]interface{}{
+		common.AttrClientCode:     "someclient",
+		common.AttrMethod:         common.BulkRequestMethod,
+		common.AttrBulkSize:       2,
+		common.AttrHTTPStatus:     http.StatusOK,
+		common.AttrErrorCode:      0,
+		common.AttrGenerationTime: 0.5,
+	}, httpSpan.attributes)
+
+	sessionSpan := tracer.spans[1]
+	assert.Equal(t, common.SpanSession, sessionSpan.name)
+	assert.Equal(t, httpSpan, sessionSpan.parent)
+	assert.True(t, sessionSpan.ended)
+
+	for i, method := range []string{"getCustomers", "saveCustomer"} {
+		subRequestSpan := tracer.spans[i+2]
+		assert.Equal(t, common.SpanBulkSubRequest, subRequestSpan.name)
+		assert.Equal(t, httpSpan, subRequestSpan.parent)
+		assert.Equal(t, method, subRequestSpan.attributes[common.AttrMethod])
+		assert.Equal(t, i, subRequestSpan.attributes[common.AttrSubRequestIndex])
+	}
+	assert.Equal(t, int(common.RequiredParamMissing), tracer.spans[3].attributes[common.AttrErrorCode])
+}
+
+func TestContextTracerHasPriority(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":{"request":"getProducts","responseStatus":"ok"},"records":[]}`))
+	}))
+	defer srv.Close()
+
+	clientTracer := &tracerMock{}
+	ctxTracer := &tracerMock{}
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithTracer(clientTracer)
+	cli := constr.Build()
+
+	_, err := cli.SendRequest(common.ContextWithTracer(context.Background(), ctxTracer), "getProducts", map[string]string{})
+	assert.NoError(t, err)
+
+	assert.Empty(t, clientTracer.spans)
+	if assert.Len(t, ctxTracer.spans, 2) {
+		assert.Equal(t, "getProducts", ctxTracer.spans[0].attributes[common.AttrMethod])
+	}
+}
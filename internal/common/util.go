@@ -1,6 +1,7 @@
 package common
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -11,12 +12,10 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
-type BulkInput struct {
-	MethodName string
-	Filters    map[string]interface{}
-}
+type BulkInput = common.BulkInput
 
 func IsJSONResponseOK(responseStatus *common.Status) bool {
 	return strings.EqualFold(responseStatus.ResponseStatus, "ok")
@@ -31,6 +30,29 @@ func getHTTPRequest(cli *Client, body io.Reader) (*http.Request, error) {
 	return req, err
 }
 
+//NewPostRequest builds a POST request to the API, the values of SensitiveParams are sent in the form encoded body
+//and the rest of the parameters in the URL, so credentials don't end up in proxy logs or net/http error messages
+func NewPostRequest(ctx context.Context, requestURL string, params url.Values) (*http.Request, error) {
+	query := url.Values{}
+	body := url.Values{}
+	for name, values := range params {
+		if common.IsSensitiveParam(name) {
+			body[name] = values
+		} else {
+			query[name] = values
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, strings.NewReader(body.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = query.Encode()
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return req, nil
+}
+
 const (
 	clientCode = "clientCode"
 	sessionKey = "sessionKey"
@@ -55,34 +77,132 @@ func setParams(params url.Values, filters map[string]string) {
 }
 
 func (cli *Client) SendRequest(ctx context.Context, apiMethod string, filters map[string]string) (*http.Response, error) {
-	log.Log.Log(log.Debug, "will call %s with filters %+v", apiMethod, filters)
-	req, err := getHTTPRequest(cli, nil)
+	cli.getLogger(ctx).LogFields(log.Debug, fmt.Sprintf("will call %s with filters %+v", apiMethod, common.RedactParams(filters)))
+
+	resp, err := cli.handle(ctx, &common.Request{
+		Method:  apiMethod,
+		Filters: filters,
+		Header:  http.Header{},
+	})
 	if err != nil {
-		return nil, common.NewFromError("failed to build http request", err, 0)
+		return nil, err
+	}
+
+	cli.getLogger(ctx).LogFields(log.Debug, fmt.Sprintf("got response with code: %d", resp.HTTPResponse.StatusCode))
+	return resp.HTTPResponse, nil
+}
+
+//handle passes the request through the middlewares and gives the buffered response body back to the caller
+func (cli *Client) handle(ctx context.Context, req *common.Request) (*common.Response, error) {
+	ctx = cli.withTracer(ctx)
+
+	started := time.Now()
+	resp, err := cli.buildHandler()(ctx, req)
+	cli.logRequest(ctx, req, started, resp, err)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, common.NewFromError(getRequestName(req)+" got no response from the middlewares", nil, 0)
 	}
-	req = req.WithContext(ctx)
-	params := cli.headersFunc(apiMethod)
-	log.Log.Log(log.Debug, "extracted headers %+v", params)
 
-	params, err = cli.addSessionParams(params)
+	if resp.HTTPResponse == nil {
+		//a middleware has answered the request itself, e.g. from a cache
+		resp.HTTPResponse = &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{},
+		}
+	}
+	if resp.Status == nil && resp.BulkStatuses == nil {
+		resp.Status, resp.BulkStatuses = common.DecodeStatuses(resp.Body)
+	}
+	resp.HTTPResponse.Body = ioutil.NopCloser(bytes.NewReader(resp.Body))
+	resp.HTTPResponse.ContentLength = int64(len(resp.Body))
+
+	return resp, nil
+}
+
+//buildHandler wraps the HTTP sending logic with the user middlewares followed by the internal request processing steps
+func (cli *Client) buildHandler() common.RequestHandler {
+	middlewares := make([]common.Middleware, 0, len(cli.middlewares)+6)
+	middlewares = append(middlewares, cli.middlewares...)
+	middlewares = append(middlewares, cli.retry, cli.renewSession, cli.trackQuota, cli.limitRate, cli.measure, cli.trace)
+
+	return common.ChainMiddlewares(cli.sendHTTPRequest, middlewares...)
+}
+
+func (cli *Client) sendHTTPRequest(ctx context.Context, req *common.Request) (*common.Response, error) {
+	var (
+		httpReq *http.Request
+		err     error
+	)
+	if req.IsBulk() {
+		httpReq, err = cli.buildBulkHTTPRequest(ctx, req)
+	} else {
+		httpReq, err = cli.buildHTTPRequest(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	setParams(params, filters)
+	for headerName, headerValues := range req.Header {
+		for _, headerValue := range headerValues {
+			httpReq.Header.Add(headerName, headerValue)
+		}
+	}
 
-	req.URL.RawQuery = params.Encode()
-	resp, err := doRequest(req, cli)
+	httpResp, err := doRequest(httpReq.WithContext(ctx), cli)
 	if err != nil {
-		return nil, common.NewFromError(fmt.Sprintf("%v request failed", apiMethod), err, 0)
+		if req.IsBulk() {
+			return nil, common.NewFromError("Bulk request failed", err, 0)
+		}
+		return nil, common.NewFromError(fmt.Sprintf("%v request failed", req.Method), err, 0)
 	}
-	log.Log.Log(log.Debug, "got response with code: %d", resp.StatusCode)
+	defer httpResp.Body.Close()
+
+	body, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, common.NewFromError("failed to read response body", err, 0)
+	}
+
+	resp := &common.Response{
+		HTTPResponse: httpResp,
+		Body:         body,
+	}
+	resp.Status, resp.BulkStatuses = common.DecodeStatuses(body)
+
 	return resp, nil
 }
 
-func (cli *Client) addSessionParams(params url.Values) (url.Values, error) {
+func (cli *Client) buildHTTPRequest(ctx context.Context, req *common.Request) (*http.Request, error) {
+	params := cli.headersFunc(req.Method)
+	cli.getLogger(ctx).LogFields(log.Debug, fmt.Sprintf("extracted headers %+v", common.RedactValues(params)))
+
+	params, err := cli.addSessionParams(ctx, req, params)
+	if err != nil {
+		return nil, err
+	}
+
+	setParams(params, req.Filters)
+
+	httpReq, err := NewPostRequest(context.Background(), cli.Url, params)
+	if err != nil {
+		return nil, common.NewFromError("failed to build http request", err, 0)
+	}
+
+	return httpReq, nil
+}
+
+func (cli *Client) addSessionParams(ctx context.Context, req *common.Request, params url.Values) (url.Values, error) {
+	_, span := common.StartSpan(ctx, common.SpanSession, common.Attr(common.AttrClientCode, cli.getClientCode()))
 	sk, err := cli.sessionProvider.GetSession()
+	span.End(err)
 	params.Add(sessionKey, sk)
+	req.SessionKey = sk
 
 	return params, err
 }
@@ -95,14 +215,12 @@ func (cli *Client) GetSession() (sessionKey string, err error) {
 	return cli.sessionProvider.GetSession()
 }
 
-type DestRespWithStatus interface {
-	GetStatus() *common.Status
-}
+type DestRespWithStatus = common.DestRespWithStatus
 
 func (cli *Client) Scan(ctx context.Context, apiMethod string, filters map[string]string, dest DestRespWithStatus) error {
 	resp, err := cli.SendRequest(ctx, apiMethod, filters)
 	if err != nil {
-		return common.NewFromError(apiMethod +" request failed", err, 0)
+		return common.NewFromError(apiMethod+" request failed", err, 0)
 	}
 
 	body, err := ioutil.ReadAll(resp.Body)
@@ -116,25 +234,95 @@ func (cli *Client) Scan(ctx context.Context, apiMethod string, filters map[strin
 
 	status := dest.GetStatus()
 	if !IsJSONResponseOK(dest.GetStatus()) {
-		return common.NewErplyErrorf(
+		erplyErr := common.NewErplyErrorf(
 			status.ErrorCode.String(),
 			"request name: %s, error field: %s, response status: %s, body: %s",
 			status.ErrorCode,
 			status.Request,
 			status.ErrorField,
 			status.ResponseStatus,
-			string(body),
+			common.RedactText(string(body)),
 		)
+		erplyErr.Request = status.Request
+		erplyErr.ErrorField = status.ErrorField
+		return erplyErr
 	}
 
 	return nil
 }
 
+//ScanBulk sends the inputs as bulk sub-requests and gives the raw sub-responses, BulkError is returned together
+//with the response if some of the sub-requests failed
+func (cli *Client) ScanBulk(ctx context.Context, inputs []BulkInput, filters map[string]string) (common.BulkResponse, error) {
+	var bulkResp common.BulkResponse
+	resp, err := cli.SendRequestBulk(ctx, inputs, filters)
+	if err != nil {
+		return bulkResp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return bulkResp, err
+	}
+
+	if err := json.Unmarshal(body, &bulkResp); err != nil {
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal BulkResponse from '%s': %v", common.RedactText(string(body)), err)
+	}
+	if !IsJSONResponseOK(&bulkResp.Status) {
+		return bulkResp, common.NewFromResponseStatus(&bulkResp.Status)
+	}
+
+	bulkErr := &common.BulkError{}
+	for i, bulkItem := range bulkResp.BulkItems {
+		bulkErr.Add(i, bulkItem.Status)
+	}
+	if bulkErr.HasFailures() {
+		return bulkResp, bulkErr
+	}
+
+	return bulkResp, nil
+}
+
+//SendRequestBulk sends the inputs as bulk sub-requests, if there are more than MaxBulkRequestsCount inputs,
+//they are split into multiple bulk requests and the sub-responses are merged in the order of inputs
 func (cli *Client) SendRequestBulk(ctx context.Context, inputs []BulkInput, filters map[string]string) (*http.Response, error) {
-	log.Log.Log(log.Debug, "will call Bulk request with inputs %+v and filters %+v", inputs, filters)
-	bulkRequest := make([]map[string]interface{}, 0, len(inputs))
-	for _, input := range inputs {
-		bulkItemFilters := input.Filters
+	cli.getLogger(ctx).LogFields(
+		log.Debug,
+		fmt.Sprintf("will call Bulk request with inputs %+v and filters %+v", common.RedactBulkInputs(inputs), common.RedactParams(filters)),
+	)
+
+	if inputs == nil {
+		inputs = []BulkInput{}
+	}
+
+	var (
+		resp *common.Response
+		err  error
+	)
+	if len(inputs) > common.MaxBulkRequestsCount {
+		resp, err = cli.sendRequestBulkChunked(ctx, inputs, filters)
+	} else {
+		resp, err = cli.handle(ctx, &common.Request{
+			Filters:    filters,
+			BulkInputs: inputs,
+			Header:     http.Header{},
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cli.getLogger(ctx).LogFields(log.Debug, fmt.Sprintf("got response from Bulk API with status %d", resp.HTTPResponse.StatusCode))
+	return resp.HTTPResponse, nil
+}
+
+func (cli *Client) buildBulkHTTPRequest(ctx context.Context, req *common.Request) (*http.Request, error) {
+	bulkRequest := make([]map[string]interface{}, 0, len(req.BulkInputs))
+	for _, input := range req.BulkInputs {
+		bulkItemFilters := make(map[string]interface{}, len(input.Filters)+1)
+		for filterKey, filterValue := range input.Filters {
+			bulkItemFilters[filterKey] = filterValue
+		}
 		bulkItemFilters["requestName"] = input.MethodName
 
 		bulkRequest = append(bulkRequest, bulkItemFilters)
@@ -145,13 +333,11 @@ func (cli *Client) SendRequestBulk(ctx context.Context, inputs []BulkInput, filt
 		return nil, common.NewFromError("failed to build requests payload", err, 0)
 	}
 
-	filters["requests"] = string(jsonRequests)
-
 	var params url.Values
 	if cli.headersFunc != nil {
 		params = cli.headersFunc("")
 		params.Del("request")
-		params, err = cli.addSessionParams(params)
+		params, err = cli.addSessionParams(ctx, req, params)
 		if err != nil {
 			return nil, err
 		}
@@ -160,22 +346,17 @@ func (cli *Client) SendRequestBulk(ctx context.Context, inputs []BulkInput, filt
 		params = make(url.Values)
 	}
 
-	setParams(params, filters)
+	setParams(params, req.Filters)
+	params.Set("requests", string(jsonRequests))
 
-	req, err := getHTTPRequest(cli, strings.NewReader(params.Encode()))
+	httpReq, err := getHTTPRequest(cli, strings.NewReader(params.Encode()))
 	if err != nil {
 		return nil, common.NewFromError("failed to build http request", err, 0)
 	}
 
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req = req.WithContext(ctx)
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	resp, err := doRequest(req, cli)
-	if err != nil {
-		return nil, common.NewFromError("Bulk request failed", err, 0)
-	}
-	log.Log.Log(log.Debug, "got response from Bulk API with status %d", resp.StatusCode)
-	return resp, nil
+	return httpReq, nil
 }
 
 func doRequest(req *http.Request, cli *Client) (*http.Response, error) {
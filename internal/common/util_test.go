@@ -2,7 +2,10 @@ package common
 
 import (
 	"context"
+	"errors"
+	"github.com/erply/api-go-wrapper/pkg/api/common"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -74,3 +77,206 @@ func TestSendRequestBulk(t *testing.T) {
 	assert.Equal(t, 200, resp.StatusCode)
 	assert.Equal(t, 1, calledTimes)
 }
+
+func TestMiddlewares(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		AssertFormValues(t, r, map[string]interface{}{
+			"request":     "getProducts",
+			"productID":   "123",
+			"rewrittenBy": "middleware",
+		})
+		assert.Equal(t, "some value", r.Header.Get("X-Custom-Header"))
+
+		_, err := w.Write([]byte(`{"status":{"request":"getProducts","responseStatus":"error","errorCode":1002},"records":[]}`))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	var seenRequest *common.Request
+	var seenStatus *common.Status
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithMiddlewares(func(next common.RequestHandler) common.RequestHandler {
+		return func(ctx context.Context, req *common.Request) (*common.Response, error) {
+			seenRequest = req
+			req.Filters["rewrittenBy"] = "middleware"
+			req.Header.Set("X-Custom-Header", "some value")
+
+			resp, err := next(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			seenStatus = resp.Status
+
+			return resp, nil
+		}
+	})
+	cli := constr.Build()
+
+	resp, err := cli.SendRequest(context.Background(), "getProducts", map[string]string{"productID": "123"})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	assert.Equal(t, "getProducts", seenRequest.Method)
+	assert.False(t, seenRequest.IsBulk())
+	assert.Equal(t, common.HourlyRequestQuota, seenStatus.ErrorCode)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"errorCode":1002`)
+}
+
+func TestMiddlewareAnsweringRequest(t *testing.T) {
+	body := []byte(`{"status":{"request":"getProducts","responseStatus":"ok"},"records":[]}`)
+	constr := &ClientConstructor{}
+	constr.WithURL("http://localhost:1")
+	constr.WithMiddlewares(func(next common.RequestHandler) common.RequestHandler {
+		return func(ctx context.Context, req *common.Request) (*common.Response, error) {
+			if req.Method == "getProducts" {
+				return &common.Response{Body: body}, nil
+			}
+			return nil, nil
+		}
+	})
+	cli := constr.Build()
+
+	resp, err := cli.SendRequest(context.Background(), "getProducts", map[string]string{})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	respBody, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, body, respBody)
+
+	_, err = cli.SendRequest(context.Background(), "getCustomers", map[string]string{})
+	assert.EqualError(t, err, "ERPLY API: getCustomers got no response from the middlewares, status: Error, code: 0")
+
+	_, err = cli.SendRequestBulk(context.Background(), []BulkInput{{MethodName: "getCustomers"}}, map[string]string{})
+	assert.Error(t, err)
+}
+
+func TestMiddlewaresBulk(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		AssertRequestBulk(t, r, []map[string]interface{}{
+			{
+				"requestName": "getProducts",
+				"pageNo":      "1",
+			},
+			{
+				"requestName": "getProducts",
+				"pageNo":      "2",
+			},
+		})
+
+		_, err := w.Write([]byte(`{"status":{"responseStatus":"ok"},"requests":[{"status":{"responseStatus":"ok"}},{"status":{"responseStatus":"error","errorCode":1016,"errorField":"pageNo"}}]}`))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	var seenRequest *common.Request
+	var seenResponse *common.Response
+	constr := &ClientConstructor{}
+	constr.WithURL(srv.URL)
+	constr.WithMiddlewares(func(next common.RequestHandler) common.RequestHandler {
+		return func(ctx context.Context, req *common.Request) (resp *common.Response, err error) {
+			seenRequest = req
+			req.BulkInputs = append(req.BulkInputs, BulkInput{
+				MethodName: "getProducts",
+				Filters:    map[string]interface{}{"pageNo": "2"},
+			})
+			seenResponse, err = next(ctx, req)
+
+			return seenResponse, err
+		}
+	})
+	cli := constr.Build()
+
+	_, err := cli.SendRequestBulk(
+		context.Background(),
+		[]BulkInput{
+			{
+				MethodName: "getProducts",
+				Filters:    map[string]interface{}{"pageNo": "1"},
+			},
+		},
+		map[string]string{},
+	)
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	assert.True(t, seenRequest.IsBulk())
+	assert.Equal(t, "ok", seenResponse.Status.ResponseStatus)
+	assert.Len(t, seenResponse.BulkStatuses, 2)
+	assert.Equal(t, common.InvalidValue, seenResponse.BulkStatuses[1].ErrorCode)
+	assert.Equal(t, "pageNo", seenResponse.BulkStatuses[1].ErrorField)
+}
+
+func TestSendRequestSensitiveParamsInBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "", r.URL.Query().Get("sessionKey"))
+		assert.Equal(t, "", r.URL.Query().Get("cardCode"))
+		assert.Equal(t, "getProducts", r.URL.Query().Get("request"))
+
+		assert.Equal(t, "somesess", r.PostFormValue("sessionKey"))
+		assert.Equal(t, "1234", r.PostFormValue("cardCode"))
+
+		_, err := w.Write([]byte(`{"status":{"responseStatus":"ok"},"records":[]}`))
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	cli := NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	_, err := cli.SendRequest(context.Background(), "getProducts", map[string]string{"cardCode": "1234"})
+	assert.NoError(t, err)
+}
+
+type statusResponse struct {
+	Status common.Status `json:"status"`
+}
+
+func (sr *statusResponse) GetStatus() *common.Status {
+	return &sr.Status
+}
+
+func TestScanErrorIsRedacted(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"status":{"request":"verifyUser","responseStatus":"error","errorCode":1016},"records":[{"sessionKey":"secretsess","token":"secrettoken"}]}`))
+		assert.NoError(t, err)
+	}))
+
+	srvURL := srv.URL
+	defer srv.Close()
+
+	cli := NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srvURL
+
+	err := cli.Scan(context.Background(), "verifyUser", map[string]string{}, &statusResponse{})
+	assert.Error(t, err)
+	if err != nil {
+		assert.NotContains(t, err.Error(), "secretsess")
+		assert.NotContains(t, err.Error(), "secrettoken")
+	}
+	erplyErr := &common.ErplyError{}
+	if assert.True(t, errors.As(err, &erplyErr)) {
+		assert.NotContains(t, erplyErr.Message, "secretsess")
+		assert.NotContains(t, erplyErr.Message, "secrettoken")
+		assert.Contains(t, erplyErr.Message, common.RedactedValue)
+	}
+
+	srv.Close()
+	_, err = cli.SendRequest(context.Background(), "getProducts", map[string]string{"password": "secretpass"})
+	assert.Error(t, err)
+	if err != nil {
+		assert.NotContains(t, err.Error(), "somesess")
+		assert.NotContains(t, err.Error(), "secretpass")
+	}
+}
@@ -0,0 +1,27 @@
+//Command fakegen writes the fakes of the Manager interfaces, run it with go generate in pkg/api/fakes
+package main
+
+import (
+	"flag"
+	"github.com/erply/api-go-wrapper/internal/fakegen"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+)
+
+func main() {
+	apiDir := flag.String("api", "..", "path of the pkg/api directory")
+	outDir := flag.String("out", ".", "directory of the generated fakes")
+	flag.Parse()
+
+	files, err := fakegen.Generate(*apiDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(*outDir, name), src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,449 @@
+//Package fakegen generates the fakes of pkg/api/fakes from the Manager interfaces of the SDK
+package fakegen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/erply/api-go-wrapper/pkg/api/fakeapi"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const modulePath = "github.com/erply/api-go-wrapper"
+
+//Interface is an interface of the SDK which gets a fake
+type Interface struct {
+	//Package is the directory of the interface under pkg/api, it's also the name of the package
+	Package string
+	//Name is the name of the interface
+	Name string
+	//FakeName is the name of the generated type, the file is named after it
+	FakeName string
+}
+
+//Interfaces are the interfaces which get fakes, add new Managers here
+var Interfaces = []Interface{
+	{Package: "addresses", Name: "Manager", FakeName: "AddressManager"},
+	{Package: "auth", Name: "Provider", FakeName: "AuthProvider"},
+	{Package: "company", Name: "Manager", FakeName: "CompanyManager"},
+	{Package: "customers", Name: "Manager", FakeName: "CustomerManager"},
+	{Package: "documents", Name: "Manager", FakeName: "DocumentManager"},
+	{Package: "pos", Name: "Manager", FakeName: "PosManager"},
+	{Package: "prices", Name: "Manager", FakeName: "PriceManager"},
+	{Package: "products", Name: "Manager", FakeName: "ProductManager"},
+	{Package: "sales", Name: "Manager", FakeName: "SalesManager"},
+	{Package: "servicediscovery", Name: "ServiceDiscoverer", FakeName: "ServiceDiscoverer"},
+	{Package: "warehouse", Name: "Manager", FakeName: "WarehouseManager"},
+}
+
+//requestNames are the ERPLY requests of the methods which are not named after them
+var requestNames = map[string]string{
+	"sales.DeleteDocument": "deleteSalesDocument",
+}
+
+//importAliases are the names used for the imported packages which differ from the last element of their paths
+var importAliases = map[string]string{
+	modulePath + "/pkg/api/common": "sharedCommon",
+}
+
+//Generate gives the sources of the fakes by the file names, apiDir is the path of pkg/api
+func Generate(apiDir string) (map[string][]byte, error) {
+	store := fakeapi.NewStore()
+	files := map[string][]byte{}
+
+	for _, iface := range Interfaces {
+		methods, err := parseInterface(filepath.Join(apiDir, iface.Package), iface.Package, iface.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		src, err := generateFake(iface, methods, store)
+		if err != nil {
+			return nil, err
+		}
+		files[lowerFirst(iface.FakeName)+".go"] = src
+	}
+
+	return files, nil
+}
+
+type param struct {
+	name string
+	expr ast.Expr
+	//imports are the files imports by their names, they are used to resolve the qualified types
+	imports map[string]string
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []param
+}
+
+//parseInterface collects the methods of the interface including the ones of the embedded interfaces
+func parseInterface(dir, pkgName, name string) ([]method, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, fmt.Errorf("fakegen: failed to parse %s: %w", dir, err)
+	}
+	pkg, ok := pkgs[pkgName]
+	if !ok {
+		return nil, fmt.Errorf("fakegen: package %s not found in %s", pkgName, dir)
+	}
+
+	type interfaceDecl struct {
+		iface   *ast.InterfaceType
+		imports map[string]string
+	}
+	interfaces := map[string]interfaceDecl{}
+	for _, file := range pkg.Files {
+		imports := map[string]string{}
+		for _, imp := range file.Imports {
+			importPath, _ := strconv.Unquote(imp.Path.Value)
+			importName := path.Base(importPath)
+			if imp.Name != nil {
+				importName = imp.Name.Name
+			}
+			imports[importName] = importPath
+		}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			typeSpec, ok := node.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			if iface, ok := typeSpec.Type.(*ast.InterfaceType); ok {
+				interfaces[typeSpec.Name.Name] = interfaceDecl{iface: iface, imports: imports}
+			}
+			return false
+		})
+	}
+
+	var collect func(name string) ([]method, error)
+	collect = func(name string) ([]method, error) {
+		decl, ok := interfaces[name]
+		if !ok {
+			return nil, fmt.Errorf("fakegen: interface %s.%s not found", pkgName, name)
+		}
+
+		var methods []method
+		for _, field := range decl.iface.Methods.List {
+			switch fieldType := field.Type.(type) {
+			case *ast.Ident:
+				embedded, err := collect(fieldType.Name)
+				if err != nil {
+					return nil, err
+				}
+				methods = append(methods, embedded...)
+			case *ast.FuncType:
+				m := method{name: field.Names[0].Name}
+				m.params = collectParams(fieldType.Params, "p", decl.imports)
+				m.results = collectParams(fieldType.Results, "r", decl.imports)
+				methods = append(methods, m)
+			default:
+				return nil, fmt.Errorf("fakegen: unsupported embedded type in %s.%s", pkgName, name)
+			}
+		}
+
+		return methods, nil
+	}
+
+	return collect(name)
+}
+
+//collectParams gives the parameters with their names, the unnamed ones are named with the prefix and their index
+func collectParams(fields *ast.FieldList, prefix string, imports map[string]string) []param {
+	if fields == nil {
+		return nil
+	}
+
+	var params []param
+	for _, field := range fields.List {
+		if len(field.Names) == 0 {
+			params = append(params, param{name: prefix + strconv.Itoa(len(params)), expr: field.Type, imports: imports})
+			continue
+		}
+		for _, fieldName := range field.Names {
+			params = append(params, param{name: fieldName.Name, expr: field.Type, imports: imports})
+		}
+	}
+
+	return params
+}
+
+type fakeWriter struct {
+	iface   Interface
+	buf     bytes.Buffer
+	imports map[string]string
+	err     error
+}
+
+func (w *fakeWriter) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&w.buf, format, args...)
+}
+
+//typeString prints the type as it's written in the fakes package and registers the imports it needs
+func (w *fakeWriter) typeString(p param, expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if types.Universe.Lookup(t.Name) != nil {
+			return t.Name
+		}
+		return w.qualified(modulePath+"/pkg/api/"+w.iface.Package, t.Name)
+	case *ast.SelectorExpr:
+		pkgIdent, ok := t.X.(*ast.Ident)
+		if !ok {
+			break
+		}
+		importPath, ok := p.imports[pkgIdent.Name]
+		if !ok {
+			w.err = fmt.Errorf("fakegen: unknown package %s in %s.%s", pkgIdent.Name, w.iface.Package, w.iface.Name)
+			return ""
+		}
+		return w.qualified(importPath, t.Sel.Name)
+	case *ast.StarExpr:
+		return "*" + w.typeString(p, t.X)
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return "[]" + w.typeString(p, t.Elt)
+		}
+	case *ast.MapType:
+		return "map[" + w.typeString(p, t.Key) + "]" + w.typeString(p, t.Value)
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return "interface{}"
+		}
+	case *ast.Ellipsis:
+		return "..." + w.typeString(p, t.Elt)
+	}
+
+	w.err = fmt.Errorf("fakegen: unsupported type %T in %s.%s", expr, w.iface.Package, w.iface.Name)
+
+	return ""
+}
+
+func (w *fakeWriter) qualified(importPath, name string) string {
+	alias, ok := importAliases[importPath]
+	if !ok {
+		alias = path.Base(importPath)
+	}
+	w.imports[importPath] = alias
+
+	return alias + "." + name
+}
+
+func (w *fakeWriter) signature(m method) (params string, results string) {
+	paramStrings := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		paramStrings = append(paramStrings, p.name+" "+w.typeString(p, p.expr))
+	}
+
+	resultStrings := make([]string, 0, len(m.results))
+	for _, r := range m.results {
+		resultStrings = append(resultStrings, w.typeString(r, r.expr))
+	}
+
+	params = "(" + strings.Join(paramStrings, ", ") + ")"
+	switch len(resultStrings) {
+	case 0:
+	case 1:
+		results = " " + resultStrings[0]
+	default:
+		results = " (" + strings.Join(resultStrings, ", ") + ")"
+	}
+
+	return params, results
+}
+
+func generateFake(iface Interface, methods []method, store *fakeapi.Store) ([]byte, error) {
+	body := &fakeWriter{iface: iface, imports: map[string]string{}}
+	ifaceType := body.qualified(modulePath+"/pkg/api/"+iface.Package, iface.Name)
+
+	body.printf("// %s is a fake of %s, see Fake for the behaviour of its methods\n", iface.FakeName, ifaceType)
+	body.printf("type %s struct {\n\t*Fake\n", iface.FakeName)
+	for _, m := range methods {
+		params, results := body.signature(m)
+		body.printf("\t%sFunc func%s%s\n", m.name, params, results)
+	}
+	body.printf("}\n\n")
+
+	body.printf("var _ %s = (*%s)(nil)\n\n", ifaceType, iface.FakeName)
+
+	body.printf("// New%s creates a %s with a new Fake\n", iface.FakeName, iface.FakeName)
+	body.printf("func New%s() *%s {\n\treturn &%s{Fake: NewFake()}\n}\n", iface.FakeName, iface.FakeName, iface.FakeName)
+
+	for _, m := range methods {
+		if err := writeMethod(body, m, store); err != nil {
+			return nil, err
+		}
+	}
+	if body.err != nil {
+		return nil, body.err
+	}
+
+	importPaths := make([]string, 0, len(body.imports))
+	for importPath := range body.imports {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+
+	file := &bytes.Buffer{}
+	fmt.Fprintf(file, "// Code generated by fakegen from %s.%s. DO NOT EDIT.\n\npackage fakes\n\nimport (\n", iface.Package, iface.Name)
+	for _, importPath := range importPaths {
+		if alias := body.imports[importPath]; alias != path.Base(importPath) {
+			fmt.Fprintf(file, "\t%s %q\n", alias, importPath)
+		} else {
+			fmt.Fprintf(file, "\t%q\n", importPath)
+		}
+	}
+	fmt.Fprintf(file, ")\n\n")
+	file.Write(body.buf.Bytes())
+
+	src, err := format.Source(file.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("fakegen: failed to format %s: %w", iface.FakeName, err)
+	}
+
+	return src, nil
+}
+
+func writeMethod(w *fakeWriter, m method, store *fakeapi.Store) error {
+	if len(m.results) == 0 || w.typeString(m.results[len(m.results)-1], m.results[len(m.results)-1].expr) != "error" {
+		return fmt.Errorf("fakegen: %s.%s.%s doesn't return an error as the last result", w.iface.Package, w.iface.Name, m.name)
+	}
+
+	params, results := w.signature(m)
+	resultNames := make([]string, 0, len(m.results))
+	for i := 0; i < len(m.results)-1; i++ {
+		resultNames = append(resultNames, "r"+strconv.Itoa(i))
+	}
+	returnValues := func(err string) string {
+		return strings.Join(append(append([]string{}, resultNames...), err), ", ")
+	}
+
+	args := []string{strconv.Quote(m.name)}
+	callArgs := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		paramType := w.typeString(p, p.expr)
+		if strings.HasPrefix(paramType, "...") {
+			callArgs = append(callArgs, p.name+"...")
+		} else {
+			callArgs = append(callArgs, p.name)
+		}
+		if paramType != "context.Context" {
+			args = append(args, p.name)
+		}
+	}
+
+	w.printf("\n// %s implements %s.%s\n", m.name, w.iface.Package, w.iface.Name)
+	w.printf("func (f *%s) %s%s%s {\n", w.iface.FakeName, m.name, params, results)
+	for i, name := range resultNames {
+		w.printf("\tvar %s %s\n", name, w.typeString(m.results[i], m.results[i].expr))
+	}
+	w.printf("\tif err := f.record(%s); err != nil {\n\t\treturn %s\n\t}\n", strings.Join(args, ", "), returnValues("err"))
+	w.printf("\tif f.%sFunc != nil {\n\t\treturn f.%sFunc(%s)\n\t}\n", m.name, m.name, strings.Join(callArgs, ", "))
+
+	var filtersParam, bulkFiltersParam, inputParam string
+	for _, p := range m.params {
+		switch paramType := w.typeString(p, p.expr); {
+		case paramType == "map[string]string" && filtersParam == "":
+			filtersParam = p.name
+		case paramType == "[]map[string]interface{}":
+			bulkFiltersParam = p.name
+		case strings.HasPrefix(paramType, w.iface.Package+"."):
+			inputParam = p.name
+		}
+	}
+
+	baseName := m.name
+	kind := ""
+	switch {
+	case strings.HasSuffix(m.name, "Bulk") && bulkFiltersParam != "" && filtersParam != "":
+		baseName, kind = strings.TrimSuffix(m.name, "Bulk"), "bulk"
+	case strings.HasSuffix(m.name, "Count") && filtersParam != "" && len(resultNames) == 1 && w.typeString(m.results[0], m.results[0].expr) == "int":
+		baseName, kind = strings.TrimSuffix(m.name, "Count"), "count"
+	case (strings.HasSuffix(m.name, "WithFilters") || strings.HasSuffix(m.name, "WithInput")) && inputParam != "":
+		baseName, kind = strings.TrimSuffix(strings.TrimSuffix(m.name, "WithFilters"), "WithInput"), "input"
+	case filtersParam != "" && bulkFiltersParam == "":
+		kind = "filters"
+	}
+
+	requestName, ok := requestNames[w.iface.Package+"."+baseName]
+	if !ok {
+		requestName = lowerFirst(baseName)
+	}
+	if !store.Handles(requestName) || len(resultNames) > 1 {
+		kind = ""
+	}
+
+	//the methods which give an integer get the ID of the saved record
+	idField := ""
+	if (kind == "filters" || kind == "input") && len(resultNames) == 1 && isInteger(w.typeString(m.results[0], m.results[0].expr)) {
+		idField = store.SaveIDField(requestName)
+		if idField == "" {
+			kind = ""
+		}
+	}
+
+	switch {
+	case kind == "":
+		w.printf("\n\treturn %s\n", returnValues("nil"))
+	case kind == "bulk" && len(resultNames) == 1:
+		w.printf("\n\terr := f.handleBulk(%q, %s, %s, &r0)\n\n\treturn r0, err\n", requestName, bulkFiltersParam, filtersParam)
+	case kind == "bulk":
+		w.printf("\n\treturn f.handleBulk(%q, %s, %s, nil)\n", requestName, bulkFiltersParam, filtersParam)
+	case kind == "count":
+		w.printf("\n\treturn f.handleCount(%q, %s)\n", requestName, filtersParam)
+	case kind == "input":
+		w.printf("\n\tencoded, err := sharedCommon.EncodeFilters(%s)\n\tif err != nil {\n\t\treturn %s\n\t}\n", inputParam, returnValues("err"))
+		w.imports[modulePath+"/pkg/api/common"] = importAliases[modulePath+"/pkg/api/common"]
+		if len(resultNames) == 0 {
+			w.printf("\n\treturn f.handle(%q, encoded, nil)\n", requestName)
+		} else if idField != "" {
+			w.printf("\n\terr = f.handleID(%q, %q, encoded, &r0)\n\n\treturn r0, err\n", requestName, idField)
+		} else {
+			w.printf("\n\terr = f.handle(%q, encoded, &r0)\n\n\treturn r0, err\n", requestName)
+		}
+	case len(resultNames) == 0:
+		w.printf("\n\treturn f.handle(%q, %s, nil)\n", requestName, filtersParam)
+	case idField != "":
+		w.printf("\n\terr := f.handleID(%q, %q, %s, &r0)\n\n\treturn r0, err\n", requestName, idField, filtersParam)
+	default:
+		w.printf("\n\terr := f.handle(%q, %s, &r0)\n\n\treturn r0, err\n", requestName, filtersParam)
+	}
+	w.printf("}\n")
+
+	return nil
+}
+
+func isInteger(typeName string) bool {
+	switch typeName {
+	case "int", "int8", "int16", "int32", "int64":
+		return true
+	}
+
+	return false
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	runes := []rune(s)
+	runes[0] = unicode.ToLower(runes[0])
+
+	return string(runes)
+}
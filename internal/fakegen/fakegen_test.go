@@ -0,0 +1,34 @@
+package fakegen
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGeneratedFakesAreUpToDate(t *testing.T) {
+	files, err := Generate(filepath.Join("..", "..", "pkg", "api"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	fakesDir := filepath.Join("..", "..", "pkg", "api", "fakes")
+	for name, src := range files {
+		checkedIn, err := ioutil.ReadFile(filepath.Join(fakesDir, name))
+		if assert.NoError(t, err, "%s is missing, run go generate in pkg/api/fakes", name) {
+			assert.Equal(t, string(src), string(checkedIn), "%s is outdated, run go generate in pkg/api/fakes", name)
+		}
+	}
+
+	entries, err := ioutil.ReadDir(fakesDir)
+	assert.NoError(t, err)
+	for _, entry := range entries {
+		data, err := ioutil.ReadFile(filepath.Join(fakesDir, entry.Name()))
+		assert.NoError(t, err)
+		if _, ok := files[entry.Name()]; !ok && strings.HasPrefix(string(data), "// Code generated by fakegen") {
+			t.Errorf("%s isn't generated any more, remove it", entry.Name())
+		}
+	}
+}
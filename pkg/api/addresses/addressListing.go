@@ -2,15 +2,18 @@ package addresses
 
 import (
 	"context"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
 )
 
 type AddressListingDataProvider struct {
 	erplyAPI Manager
+	sharedCommon.ListingClient
 }
 
 func NewAddressListingDataProvider(erplyClient Manager) *AddressListingDataProvider {
 	return &AddressListingDataProvider{
-		erplyAPI: erplyClient,
+		erplyAPI:      erplyClient,
+		ListingClient: sharedCommon.NewListingClient(erplyClient),
 	}
 }
 
@@ -41,20 +41,16 @@ func (cli *Client) GetAddressesBulk(ctx context.Context, bulkFilters []map[strin
 	}
 
 	if err := json.Unmarshal(body, &addrResp); err != nil {
-		return addrResp, fmt.Errorf("ERPLY API: failed to unmarshal GetAddressesResponseBulk from '%s': %v", string(body), err)
+		return addrResp, fmt.Errorf("ERPLY API: failed to unmarshal GetAddressesResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&addrResp.Status) {
-		return addrResp, sharedCommon.NewErplyError(addrResp.Status.ErrorCode.String(), addrResp.Status.Request+": "+addrResp.Status.ResponseStatus, addrResp.Status.ErrorCode)
+		return addrResp, sharedCommon.NewFromResponseStatus(&addrResp.Status)
 	}
 
-	for _, addrBulkItem := range addrResp.BulkItems {
-		if !common.IsJSONResponseOK(&addrBulkItem.Status.Status) {
-			return addrResp, sharedCommon.NewErplyError(addrBulkItem.Status.ErrorCode.String(), addrBulkItem.Status.Request+": "+addrBulkItem.Status.ResponseStatus, addrResp.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return addrResp, nil
+	return addrResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SaveAddress(ctx context.Context, filters map[string]string) ([]sharedCommon.Address, error) {
@@ -104,10 +100,6 @@ func (cli *Client) DeleteAddressBulk(
 ) (DeleteAddressResponseBulk, error) {
 	var bulkResp DeleteAddressResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot delete more than %d addresses in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, bulkInput := range bulkRequest {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -127,33 +119,21 @@ func (cli *Client) DeleteAddressBulk(
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal DeleteAddressResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal DeleteAddressResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SaveAddressesBulk(ctx context.Context, addrMap []map[string]interface{}, attrs map[string]string) (SaveAddressesResponseBulk, error) {
 	var saveAddressesResponseBulk SaveAddressesResponseBulk
 
-	if len(addrMap) > sharedCommon.MaxBulkRequestsCount {
-		return saveAddressesResponseBulk, fmt.Errorf("cannot save more than %d addresses in one request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(addrMap))
 	for _, addr := range addrMap {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -173,26 +153,14 @@ func (cli *Client) SaveAddressesBulk(ctx context.Context, addrMap []map[string]i
 	}
 
 	if err := json.Unmarshal(body, &saveAddressesResponseBulk); err != nil {
-		return saveAddressesResponseBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveAddressesResponseBulk from '%s': %v", string(body), err)
+		return saveAddressesResponseBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveAddressesResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&saveAddressesResponseBulk.Status) {
-		return saveAddressesResponseBulk, sharedCommon.NewErplyError(
-			saveAddressesResponseBulk.Status.ErrorCode.String(),
-			saveAddressesResponseBulk.Status.Request+": "+saveAddressesResponseBulk.Status.ResponseStatus,
-			saveAddressesResponseBulk.Status.ErrorCode,
-		)
+		return saveAddressesResponseBulk, sharedCommon.NewFromResponseStatus(&saveAddressesResponseBulk.Status)
 	}
 
-	for _, addrBulkItem := range saveAddressesResponseBulk.BulkItems {
-		if !common.IsJSONResponseOK(&addrBulkItem.Status.Status) {
-			return saveAddressesResponseBulk, sharedCommon.NewErplyError(
-				addrBulkItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", addrBulkItem.Status),
-				saveAddressesResponseBulk.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return saveAddressesResponseBulk, nil
+	return saveAddressesResponseBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
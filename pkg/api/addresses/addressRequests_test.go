@@ -275,7 +275,7 @@ func TestSaveAddressesBulkResponseFailure(t *testing.T) {
 func TestDeleteAddresses(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "someclient", r.URL.Query().Get("clientCode"))
-		assert.Equal(t, "somesess", r.URL.Query().Get("sessionKey"))
+		assert.Equal(t, "somesess", r.PostFormValue("sessionKey"))
 		assert.Equal(t, "deleteAddress", r.URL.Query().Get("request"))
 		assert.Equal(t, "2223", r.URL.Query().Get("addressID"))
 
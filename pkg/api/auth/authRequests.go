@@ -22,12 +22,10 @@ func VerifyUser(username, password, clientCode string, client *http.Client) (str
 	params.Add("password", password)
 	params.Add("request", "verifyUser")
 
-	req, err := http.NewRequest("POST", requestUrl, nil)
+	req, err := common.NewPostRequest(context.Background(), requestUrl, params)
 	if err != nil {
 		return "", sharedCommon.NewFromError("failed to build HTTP request", err, 0)
 	}
-
-	req.URL.RawQuery = params.Encode()
 	req.Header.Add("Accept", "application/json")
 	resp, err := client.Do(req)
 
@@ -53,11 +51,10 @@ func VerifyUserV2(ctx context.Context, filters map[string]string, clientCode str
 		params.Add(k, v)
 	}
 	params.Add("request", "verifyUser")
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestUrl, nil)
+	req, err := common.NewPostRequest(ctx, requestUrl, params)
 	if err != nil {
 		return "", sharedCommon.NewFromError("failed to build HTTP request", err, 0)
 	}
-	req.URL.RawQuery = params.Encode()
 	req.Header.Add("Accept", "application/json")
 	resp, err := cli.Do(req)
 
@@ -82,11 +79,10 @@ func VerifyUserV3(ctx context.Context, filters map[string]string, clientCode str
 		params.Add(k, v)
 	}
 	params.Add("request", "verifyUser")
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestUrl, nil)
+	req, err := common.NewPostRequest(ctx, requestUrl, params)
 	if err != nil {
 		return nil, sharedCommon.NewFromError("failed to build HTTP request", err, 0)
 	}
-	req.URL.RawQuery = params.Encode()
 	req.Header.Add("Accept", "application/json")
 	resp, err := cli.Do(req)
 
@@ -119,11 +115,10 @@ func VerifyUserFull(ctx context.Context, username, password, clientCode string,
 	params.Add("clientCode", clientCode)
 	params.Add("password", password)
 	params.Add("request", "verifyUser")
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestUrl, nil)
+	req, err := common.NewPostRequest(ctx, requestUrl, params)
 	if err != nil {
 		return nil, sharedCommon.NewFromError("failed to build HTTP request", err, 0)
 	}
-	req.URL.RawQuery = params.Encode()
 	req.Header.Add("Accept", "application/json")
 	resp, err := cli.Do(req)
 
@@ -159,11 +154,10 @@ func SwitchUser(ctx context.Context, sessionKey, pin, clientCode string, inputPa
 	params.Add("cardCode", pin)
 	params.Add("clientCode", clientCode)
 	params.Add("request", "switchUser")
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestUrl, nil)
+	req, err := common.NewPostRequest(ctx, requestUrl, params)
 	if err != nil {
 		return nil, sharedCommon.NewFromError("failed to build HTTP request", err, 0)
 	}
-	req.URL.RawQuery = params.Encode()
 	req.Header.Add("Accept", "application/json")
 	resp, err := cli.Do(req)
 
@@ -197,12 +191,10 @@ func GetSessionKeyUser(sessionKey string, clientCode string, client HttpClient)
 	params.Add("request", "getSessionKeyUser")
 	params.Add("clientCode", clientCode)
 
-	req, err := http.NewRequest("POST", requestUrl, nil)
+	req, err := common.NewPostRequest(context.Background(), requestUrl, params)
 	if err != nil {
 		return nil, sharedCommon.NewFromError("failed to build HTTP request", err, 0)
 	}
-
-	req.URL.RawQuery = params.Encode()
 	req.Header.Add("Accept", "application/json")
 
 	resp, err := client.Do(req)
@@ -220,7 +212,7 @@ func GetSessionKeyUser(sessionKey string, clientCode string, client HttpClient)
 			}
 		}
 
-		return nil, fmt.Errorf("wrong response status code: %d, body: %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("wrong response status code: %d, body: %s", resp.StatusCode, sharedCommon.RedactText(string(body)))
 	}
 
 	res := &SessionKeyUserResponse{}
@@ -241,19 +233,17 @@ func GetSessionKeyInfo(sessionKey string, clientCode string, client HttpClient)
 	params.Add("request", "getSessionKeyInfo")
 	params.Add("clientCode", clientCode)
 
-	req, err := http.NewRequest("POST", requestUrl, nil)
+	req, err := common.NewPostRequest(context.Background(), requestUrl, params)
 	if err != nil {
 		return nil, sharedCommon.NewFromError("failed to build HTTP request", err, 0)
 	}
-
-	req.URL.RawQuery = params.Encode()
 	req.Header.Add("Accept", "application/json")
 
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, sharedCommon.NewFromError("failed to call getSessionKeyInfo request", err, 0)
 	}
-	resp.Body.Close()
+	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
 		var body []byte
@@ -264,7 +254,7 @@ func GetSessionKeyInfo(sessionKey string, clientCode string, client HttpClient)
 			}
 		}
 
-		return nil, fmt.Errorf("wrong response status code: %d, body: %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("wrong response status code: %d, body: %s", resp.StatusCode, sharedCommon.RedactText(string(body)))
 	}
 
 	res := &SessionKeyInfoResponse{}
@@ -58,9 +58,10 @@ func TestGetSessionKeyUserSuccess(t *testing.T) {
 	req := cl.Requests[0]
 	assert.Equal(
 		t,
-		"https://code123.erply.com/api/?clientCode=code123&doNotGenerateIdentityToken=1&request=getSessionKeyUser&sessionKey=sess123",
+		"https://code123.erply.com/api/?clientCode=code123&doNotGenerateIdentityToken=1&request=getSessionKeyUser",
 		req.URL.String(),
 	)
+	assert.Equal(t, "sess123", req.PostFormValue("sessionKey"))
 	assert.Equal(t, "application/json", req.Header.Get("Accept"))
 	assert.True(t, bodyMock.WasClosed)
 }
@@ -65,7 +65,7 @@ type (
 		Records []SessionKeyInfo `json:"records"`
 	}
 	SessionKeyInfo struct {
-		CreationUnixTime string `json:"creationUnixTime"`
-		ExpireUnixTime   string `json:"expireUnixTime"`
+		CreationUnixTime common2.Timestamp `json:"creationUnixTime"`
+		ExpireUnixTime   common2.Timestamp `json:"expireUnixTime"`
 	}
 )
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -59,6 +60,50 @@ func (cl *Client) GetSession() (sessionKey string, err error) {
 	return cl.commonClient.GetSession()
 }
 
+//GetRateLimiter gives the rate limiter which is applied to all requests of the client, it can be shared with a Lister
+//or other clients of the same account, nil means that requests are not limited
+func (cl *Client) GetRateLimiter() sharedCommon.RateLimiter {
+	return cl.commonClient.GetRateLimiter()
+}
+
+//GetMetrics gives the metrics which the requests of the client are reported to or nil if they are not collected
+func (cl *Client) GetMetrics() sharedCommon.Metrics {
+	return cl.commonClient.GetMetrics()
+}
+
+//GetQuotaTracker gives the tracker of the hourly request quota or nil if the quota is not tracked
+func (cl *Client) GetQuotaTracker() *sharedCommon.QuotaTracker {
+	return cl.commonClient.GetQuotaTracker()
+}
+
+//GetRemainingQuota gives the amount of requests which can be made in the current hour,
+//it's -1 if the quota is not tracked or the hourly limit is unknown
+func (cl *Client) GetRemainingQuota() int {
+	return cl.commonClient.GetRemainingQuota()
+}
+
+//GetLocation gives the time zone of the account, pass it to the time.Time conversions of sharedCommon.Timestamp and sharedCommon.Date
+func (cl *Client) GetLocation() *time.Location {
+	return cl.commonClient.GetLocation()
+}
+
+//SendBulk sends the sub-requests collected in the builder as one bulk call and decodes their results
+func (cl *Client) SendBulk(ctx context.Context, builder *sharedCommon.BulkBuilder, baseFilters map[string]string) error {
+	return builder.Send(ctx, cl.commonClient, baseFilters)
+}
+
+//Call sends any API request with the session and headers of the client and decodes the response into dest,
+//it's useful for the requests which are not wrapped by the managers yet
+func (cl *Client) Call(ctx context.Context, method string, filters map[string]string, dest sharedCommon.DestRespWithStatus) error {
+	return cl.commonClient.Scan(ctx, method, filters, dest)
+}
+
+//CallBulk sends any API requests as one bulk call and gives the raw sub-responses in the order of inputs,
+//BulkError is returned together with the response if some of the sub-requests failed
+func (cl *Client) CallBulk(ctx context.Context, inputs []sharedCommon.BulkInput, baseFilters map[string]string) (sharedCommon.BulkResponse, error) {
+	return cl.commonClient.ScanBulk(ctx, inputs, baseFilters)
+}
+
 //NewUnvalidatedClient returns a new Client without validating any of the incoming parameters giving the
 //developer more flexibility
 func NewUnvalidatedClient(sk, cc, partnerKey string, httpCli *http.Client) *Client {
@@ -117,16 +162,42 @@ func newErplyClient(c *common.Client) *Client {
 }
 
 type ClientBuilder struct {
-	UserName                   string                 //if set this will be used to fetch session key every time when session gets outdated
-	Password                   string                 //if set this will be used to fetch session key every time when session gets outdated
-	ClientCode                 string                 //required value for all requests
-	SessionKey                 string                 //if you don't set SessionProvider this key will be used to auth all requests
-	DefaultSessionLenSeconds   int                    //set the length of dynamically created sessions
-	URL                        string                 //change the base API url
-	PartnerKey                 string                 //set the partner key
-	HttpCli                    *http.Client           //you can adjust the http client transport options here
-	HeadersForEveryRequestFunc common.AuthFunc        //this will set headers for all outgoing requests except for the session key
-	SessionProvider            common.SessionProvider //custom session establishing logic, if not set DynamicSessionProvider is used which requires UserName and Password
+	UserName                   string                      //if set this will be used to fetch session key every time when session gets outdated
+	Password                   string                      //if set this will be used to fetch session key every time when session gets outdated
+	ClientCode                 string                      //required value for all requests
+	SessionKey                 string                      //if you don't set SessionProvider this key will be used to auth all requests
+	DefaultSessionLenSeconds   int                         //set the length of dynamically created sessions
+	URL                        string                      //change the base API url
+	PartnerKey                 string                      //set the partner key
+	HttpCli                    *http.Client                //you can adjust the http client transport options here
+	HeadersForEveryRequestFunc common.AuthFunc             //this will set headers for all outgoing requests except for the session key
+	SessionProvider            common.SessionProvider      //custom session establishing logic, if not set DynamicSessionProvider is used which requires UserName and Password
+	Middlewares                []sharedCommon.Middleware   //will wrap every request in the given order, the first one is the outermost
+	RetryPolicy                *sharedCommon.RetryPolicy   //if set failed requests will be repeated according to it
+	RateLimits                 *sharedCommon.RateLimits    //if set all requests of the client will be limited by a token bucket limiter owned by the client
+	RateLimiter                sharedCommon.RateLimiter    //custom or shared rate limiter, it has priority over RateLimits
+	Quota                      *sharedCommon.QuotaSettings //if set requests will be counted against the hourly quota by a tracker owned by the client
+	QuotaTracker               *sharedCommon.QuotaTracker  //shared quota tracker, it has priority over Quota
+	BulkConcurrency            int                         //how many requests are sent in parallel when a bulk call with more than 100 sub-requests is split, 1 by default
+	DriftHandler               sharedCommon.DriftHandler   //if set the records of the requests from ResponseModels are checked for fields which are not declared in the models
+	ResponseModels             map[string]interface{}      //maps the request names to the models of their records for DriftHandler, DefaultResponseModels are used if it's not set
+	KeepExtraFields            bool                        //if set the undeclared response fields are kept in the Extra maps of the models which support it, e.g. products.Product
+	Logger                     log.StructuredLogger        //logger for the requests and sessions of the client, if not set the global log.Log is used
+	Metrics                    sharedCommon.Metrics        //if set the request counts, latencies, session refreshes and throttling waits are reported to it
+	Tracer                     sharedCommon.Tracer         //if set the API calls and the pages of the Listers using the data providers of the client are reported as spans unless the context carries another tracer
+	SessionRefreshMargin       time.Duration               //if set the dynamic session is renewed in the background when it's valid for less than this
+	Location                   *time.Location              //the time zone of the account which is given by Client.GetLocation, UTC by default
+}
+
+//DefaultResponseModels gives the models of the records which the drift detector of ClientBuilder.DriftHandler
+//checks if ClientBuilder.ResponseModels is not set, add your own entries to the result to cover more requests
+func DefaultResponseModels() map[string]interface{} {
+	return map[string]interface{}{
+		"getProducts":       products.Product{},
+		"getCustomers":      customers.Customer{},
+		"getSalesDocuments": sales.SaleDocument{},
+		"getWarehouses":     warehouse.Warehouse{},
+	}
 }
 
 type DynamicSessionProvider struct {
@@ -138,8 +209,21 @@ type DynamicSessionProvider struct {
 	DefaultSessionLenSeconds int
 	Lock                     sync.Mutex
 	HTTPClient               *http.Client
+	URL                      string //the API url for the session requests, the url of the client code is used if it's empty
+	Logger                   log.StructuredLogger
+	Metrics                  sharedCommon.Metrics
+	//RefreshMargin enables the refresh-ahead mode: once the session is valid for less than the margin, GetSession
+	//still gives the current key and renews the session in the background. The expiry of the new sessions is read
+	//with getSessionKeyInfo. Callers wait for verifyUser only if there is no valid key
+	RefreshMargin time.Duration
+
+	refreshing      bool
+	refreshFailedAt time.Time
 }
 
+//backgroundRefreshRetryDelay is the pause after a failed background refresh before the next one is started
+const backgroundRefreshRetryDelay = 10 * time.Second
+
 func (dsp *DynamicSessionProvider) Invalidate() {
 	dsp.Lock.Lock()
 	defer dsp.Lock.Unlock()
@@ -151,17 +235,22 @@ func (dsp *DynamicSessionProvider) GetSession() (sessionKey string, err error) {
 	defer dsp.Lock.Unlock()
 
 	if dsp.isSessionValid() {
-		log.Log.Log(log.Debug, "will use the cached key which is valid till %v", dsp.SessionValidTill)
+		dsp.getLogger().LogFields(log.Debug, fmt.Sprintf("will use the cached key which is valid till %v", dsp.SessionValidTill))
+		if dsp.shouldRefreshAhead() {
+			dsp.getLogger().LogFields(log.Debug, "will renew the session in the background since it expires soon")
+			dsp.refreshing = true
+			go dsp.refreshAhead()
+		}
 		return dsp.SessionKey, nil
 	}
 
-	log.Log.Log(log.Debug, "will request new session key since the old one is not valid %v", dsp.SessionValidTill)
-	sessionKey, validTill, err := dsp.getAuthUserFromAPI()
+	dsp.getLogger().LogFields(log.Debug, fmt.Sprintf("will request new session key since the old one is not valid %v", dsp.SessionValidTill))
+	sessionKey, validTill, err := dsp.requestSession()
 	if err != nil {
 		return "", err
 	}
 
-	log.Log.Log(log.Debug, "got new session key with validity till %v", validTill)
+	dsp.getLogger().LogFields(log.Debug, fmt.Sprintf("got new session key with validity till %v", validTill))
 
 	dsp.SessionKey = sessionKey
 	dsp.SessionValidTill = validTill
@@ -169,6 +258,69 @@ func (dsp *DynamicSessionProvider) GetSession() (sessionKey string, err error) {
 	return dsp.SessionKey, nil
 }
 
+func (dsp *DynamicSessionProvider) shouldRefreshAhead() bool {
+	if dsp.RefreshMargin <= 0 || dsp.refreshing || dsp.SessionValidTill == nil {
+		return false
+	}
+	if time.Since(dsp.refreshFailedAt) < backgroundRefreshRetryDelay {
+		return false
+	}
+
+	return time.Until(*dsp.SessionValidTill) < dsp.RefreshMargin
+}
+
+//refreshAhead renews the session without holding the lock, the current key is given to the callers meanwhile
+func (dsp *DynamicSessionProvider) refreshAhead() {
+	sessionKey, validTill, err := dsp.requestSession()
+
+	dsp.Lock.Lock()
+	defer dsp.Lock.Unlock()
+
+	dsp.refreshing = false
+	if err != nil {
+		dsp.refreshFailedAt = time.Now()
+		dsp.getLogger().LogFields(log.Warn, "failed to renew the session in the background", log.F(log.FieldError, err))
+		return
+	}
+
+	dsp.getLogger().LogFields(log.Debug, fmt.Sprintf("renewed the session in the background, valid till %v", validTill))
+	dsp.SessionKey = sessionKey
+	dsp.SessionValidTill = validTill
+}
+
+//requestSession creates a new session with verifyUser, in the refresh-ahead mode its expiry is read with getSessionKeyInfo
+func (dsp *DynamicSessionProvider) requestSession() (sessionKey string, validTill *time.Time, err error) {
+	sessionKey, validTill, err = dsp.getAuthUserFromAPI()
+	if err == nil && dsp.RefreshMargin > 0 {
+		expiresAt, infoErr := dsp.getSessionExpiryFromAPI(sessionKey)
+		if infoErr != nil {
+			dsp.getLogger().LogFields(
+				log.Debug,
+				"failed to get the session expiry, will rely on the session length",
+				log.F(log.FieldMethod, "getSessionKeyInfo"),
+				log.F(log.FieldError, infoErr),
+			)
+		} else {
+			validTill = &expiresAt
+		}
+	}
+
+	if dsp.Metrics != nil {
+		dsp.Metrics.ObserveSessionRefresh(dsp.ClientCode, err)
+	}
+
+	return sessionKey, validTill, err
+}
+
+func (dsp *DynamicSessionProvider) getLogger() log.StructuredLogger {
+	logger := dsp.Logger
+	if logger == nil {
+		logger = log.GlobalLogger{}
+	}
+
+	return log.With(logger, log.F(log.FieldClientCode, dsp.ClientCode))
+}
+
 func (dsp *DynamicSessionProvider) isSessionValid() bool {
 	if dsp.SessionKey == "" {
 		return false
@@ -181,7 +333,6 @@ func (dsp *DynamicSessionProvider) isSessionValid() bool {
 }
 
 func (dsp *DynamicSessionProvider) getAuthUserFromAPI() (sessionKey string, validTill *time.Time, err error) {
-	requestUrl := fmt.Sprintf(common.BaseUrl, dsp.ClientCode)
 	params := url.Values{}
 	params.Add("username", dsp.UserName)
 	params.Add("clientCode", dsp.ClientCode)
@@ -189,34 +340,15 @@ func (dsp *DynamicSessionProvider) getAuthUserFromAPI() (sessionKey string, vali
 	params.Add("sessionLength", strconv.Itoa(dsp.DefaultSessionLenSeconds))
 	params.Add("request", "verifyUser")
 
-	log.Log.Log(log.Debug,
-		"will call verifyUser with client code %s, user name %s and session length %d seconds",
-		dsp.ClientCode,
-		dsp.UserName,
-		dsp.DefaultSessionLenSeconds,
+	dsp.getLogger().LogFields(
+		log.Debug,
+		fmt.Sprintf("will call verifyUser with user name %s and session length %d seconds", dsp.UserName, dsp.DefaultSessionLenSeconds),
+		log.F(log.FieldMethod, "verifyUser"),
 	)
 
-	req, err := http.NewRequest("POST", requestUrl, nil)
-	if err != nil {
-		return "", nil, err
-	}
-
-	client := dsp.HTTPClient
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	req.URL.RawQuery = params.Encode()
-	req.Header.Add("Accept", "application/json")
-	resp, err := client.Do(req)
-
-	if err != nil {
-		return "", nil, err
-	}
-
 	res := &auth.VerifyUserResponse{}
-	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
-		return "", nil, fmt.Errorf("failed to decode VerifyUserResponse %w", err)
+	if err := dsp.callAPI(params, res, "VerifyUserResponse"); err != nil {
+		return "", nil, err
 	}
 
 	if len(res.Records) < 1 {
@@ -235,6 +367,60 @@ func (dsp *DynamicSessionProvider) getAuthUserFromAPI() (sessionKey string, vali
 	return
 }
 
+//getSessionExpiryFromAPI gives the expiry time of the session reported by getSessionKeyInfo
+func (dsp *DynamicSessionProvider) getSessionExpiryFromAPI(sessionKey string) (time.Time, error) {
+	params := url.Values{}
+	params.Add("sessionKey", sessionKey)
+	params.Add("clientCode", dsp.ClientCode)
+	params.Add("request", "getSessionKeyInfo")
+
+	res := &auth.SessionKeyInfoResponse{}
+	if err := dsp.callAPI(params, res, "SessionKeyInfoResponse"); err != nil {
+		return time.Time{}, err
+	}
+
+	if len(res.Records) < 1 || res.Records[0].ExpireUnixTime.IsZero() {
+		return time.Time{}, &sharedCommon.ErplyError{
+			Status:  res.Status.ResponseStatus,
+			Message: "No expiry time in the SessionKeyInfoResponse",
+			Code:    res.Status.ErrorCode,
+		}
+	}
+
+	return time.Unix(res.Records[0].ExpireUnixTime.Unix(), 0).UTC(), nil
+}
+
+//callAPI sends the session request to the API and decodes the response into res, resName is used in the decoding error
+func (dsp *DynamicSessionProvider) callAPI(params url.Values, res interface{}, resName string) error {
+	requestUrl := dsp.URL
+	if requestUrl == "" {
+		requestUrl = fmt.Sprintf(common.BaseUrl, dsp.ClientCode)
+	}
+
+	req, err := common.NewPostRequest(context.Background(), requestUrl, params)
+	if err != nil {
+		return err
+	}
+
+	client := dsp.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req.Header.Add("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+		return fmt.Errorf("failed to decode %s %w", resName, err)
+	}
+
+	return nil
+}
+
 func (cb ClientBuilder) Build() *Client {
 	constr := &common.ClientConstructor{}
 	constr.WithClientCode(cb.ClientCode)
@@ -248,6 +434,11 @@ func (cb ClientBuilder) Build() *Client {
 			Pass:                     cb.Password,
 			DefaultSessionLenSeconds: cb.DefaultSessionLenSeconds,
 			Lock:                     sync.Mutex{},
+			HTTPClient:               cb.HttpCli,
+			URL:                      cb.URL,
+			Logger:                   cb.Logger,
+			Metrics:                  cb.Metrics,
+			RefreshMargin:            cb.SessionRefreshMargin,
 		}
 
 		constr.WithSessionProvider(sessProvider)
@@ -258,8 +449,46 @@ func (cb ClientBuilder) Build() *Client {
 	constr.WithHeaderFunc(cb.HeadersForEveryRequestFunc)
 	constr.WithHttpClient(cb.HttpCli)
 	constr.WithSessionKey(cb.SessionKey)
+	constr.WithMiddlewares(cb.Middlewares...)
+	if cb.DriftHandler != nil {
+		constr.WithMiddlewares(sharedCommon.NewDriftDetector(cb.responseModels(), cb.DriftHandler))
+	}
+	constr.WithRetryPolicy(cb.RetryPolicy)
+
+	if cb.RateLimiter != nil {
+		constr.WithRateLimiter(cb.RateLimiter)
+	} else if cb.RateLimits != nil {
+		constr.WithRateLimiter(sharedCommon.NewTokenBucketLimiter(*cb.RateLimits))
+	}
+
+	constr.WithBulkConcurrency(cb.BulkConcurrency)
+	constr.WithLogger(cb.Logger)
+	constr.WithMetrics(cb.Metrics)
+	constr.WithTracer(cb.Tracer)
+	constr.WithLocation(cb.Location)
+	constr.WithKeepExtraFields(cb.KeepExtraFields)
+
+	if cb.QuotaTracker != nil {
+		constr.WithQuotaTracker(cb.QuotaTracker)
+	} else if cb.Quota != nil {
+		constr.WithQuotaTracker(sharedCommon.NewQuotaTracker(*cb.Quota))
+	}
 
 	baseClient := constr.Build()
 
 	return newErplyClient(baseClient)
 }
+
+//responseModels gives a copy of ResponseModels or DefaultResponseModels, so the client isn't affected by later changes of the map
+func (cb ClientBuilder) responseModels() map[string]interface{} {
+	if cb.ResponseModels == nil {
+		return DefaultResponseModels()
+	}
+
+	models := make(map[string]interface{}, len(cb.ResponseModels))
+	for request, model := range cb.ResponseModels {
+		models[request] = model
+	}
+
+	return models
+}
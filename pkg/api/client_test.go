@@ -1 +1,301 @@
 package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/erply/api-go-wrapper/internal/common"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/customers"
+	"github.com/erply/api-go-wrapper/pkg/api/fakeapi"
+	"github.com/erply/api-go-wrapper/pkg/api/faultinject"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type getReasonCodesResponse struct {
+	Status  sharedCommon.Status `json:"status"`
+	Records []struct {
+		ReasonID int    `json:"reasonID"`
+		Name     string `json:"name"`
+	} `json:"records"`
+}
+
+func (r *getReasonCodesResponse) GetStatus() *sharedCommon.Status {
+	return &r.Status
+}
+
+func TestCall(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		common.AssertFormValues(t, r, map[string]interface{}{
+			"clientCode": "someclient",
+			"sessionKey": "somesess",
+			"request":    "getReasonCodes",
+			"purpose":    "RETURN",
+		})
+
+		_, err := w.Write([]byte(`{"status":{"responseStatus":"ok"},"records":[{"reasonID":1,"name":"Broken"}]}`))
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	cli := common.NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	c := newErplyClient(cli)
+
+	resp := &getReasonCodesResponse{}
+	err := c.Call(context.Background(), "getReasonCodes", map[string]string{"purpose": "RETURN"}, resp)
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	assert.Len(t, resp.Records, 1)
+	assert.Equal(t, 1, resp.Records[0].ReasonID)
+	assert.Equal(t, "Broken", resp.Records[0].Name)
+}
+
+func TestCallFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"status":{"request":"getReasonCodes","responseStatus":"error","errorCode":1016,"errorField":"purpose"}}`))
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	cli := common.NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	c := newErplyClient(cli)
+
+	err := c.Call(context.Background(), "getReasonCodes", map[string]string{"purpose": "unknown"}, &getReasonCodesResponse{})
+	erplyErr, ok := err.(*sharedCommon.ErplyError)
+	assert.True(t, ok)
+	if ok {
+		assert.Equal(t, sharedCommon.InvalidValue, erplyErr.Code)
+		assert.Equal(t, "getReasonCodes", erplyErr.Request)
+		assert.Equal(t, "purpose", erplyErr.ErrorField)
+	}
+	assert.True(t, errors.Is(err, sharedCommon.ErrValidation))
+	assert.False(t, errors.Is(err, sharedCommon.ErrAuth))
+}
+
+func TestCallBulk(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		common.AssertRequestBulk(t, r, []map[string]interface{}{
+			{
+				"requestName": "getReasonCodes",
+				"purpose":     "RETURN",
+			},
+			{
+				"requestName": "getReasonCodes",
+				"purpose":     "unknown",
+			},
+		})
+
+		_, err := w.Write([]byte(`{"status":{"responseStatus":"ok"},"requests":[
+			{"status":{"requestName":"getReasonCodes","responseStatus":"ok"},"records":[{"reasonID":1,"name":"Broken"}]},
+			{"status":{"requestName":"getReasonCodes","responseStatus":"error","errorCode":1016,"errorField":"purpose"},"records":null}
+		]}`))
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	cli := common.NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	c := newErplyClient(cli)
+
+	bulkResp, err := c.CallBulk(
+		context.Background(),
+		[]sharedCommon.BulkInput{
+			{
+				MethodName: "getReasonCodes",
+				Filters:    map[string]interface{}{"purpose": "RETURN"},
+			},
+			{
+				MethodName: "getReasonCodes",
+				Filters:    map[string]interface{}{"purpose": "unknown"},
+			},
+		},
+		map[string]string{},
+	)
+
+	bulkErr, ok := err.(*sharedCommon.BulkError)
+	assert.True(t, ok)
+	if ok {
+		assert.Equal(t, []int{1}, bulkErr.FailedIndexes())
+	}
+
+	assert.Len(t, bulkResp.BulkItems, 2)
+	if len(bulkResp.BulkItems) != 2 {
+		return
+	}
+
+	var records []struct {
+		ReasonID int `json:"reasonID"`
+	}
+	err = json.Unmarshal(bulkResp.BulkItems[0].Records, &records)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, records[0].ReasonID)
+
+	item := getReasonCodesResponse{}
+	err = bulkResp.BulkItems[0].Decode(&item)
+	assert.NoError(t, err)
+	assert.Equal(t, "Broken", item.Records[0].Name)
+
+	assert.Equal(t, sharedCommon.InvalidValue, bulkResp.BulkItems[1].Status.ErrorCode)
+}
+
+func TestClientBuilderDriftHandler(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"status":{"responseStatus":"ok"},"records":[{"productID":1,"code":"abc","newField":"value"}]}`))
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	reports := []sharedCommon.DriftReport{}
+	c := ClientBuilder{
+		ClientCode:      "someclient",
+		URL:             srv.URL,
+		SessionProvider: &common.DefaultSessionProvider{SessionKey: "somesess"},
+		DriftHandler: func(ctx context.Context, report sharedCommon.DriftReport) {
+			reports = append(reports, report)
+		},
+	}.Build()
+
+	prods, err := c.ProductManager.GetProducts(context.Background(), map[string]string{})
+	assert.NoError(t, err)
+	assert.Len(t, prods, 1)
+
+	assert.Equal(t, []sharedCommon.DriftReport{
+		{Request: "getProducts", Model: "products.Product", Fields: []string{"newField"}},
+	}, reports)
+}
+
+func TestClientBuilderResponseModelsAndExtraFields(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"status":{"responseStatus":"ok"},"records":[{"id":1,"customerID":1,"newField":"value"}]}`))
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	models := map[string]interface{}{"getCustomers": customers.Customer{}}
+	reports := []sharedCommon.DriftReport{}
+	c := ClientBuilder{
+		ClientCode:      "someclient",
+		URL:             srv.URL,
+		SessionProvider: &common.DefaultSessionProvider{SessionKey: "somesess"},
+		DriftHandler: func(ctx context.Context, report sharedCommon.DriftReport) {
+			reports = append(reports, report)
+		},
+		ResponseModels:  models,
+		KeepExtraFields: true,
+	}.Build()
+	delete(models, "getCustomers")
+
+	_, err := c.ProductManager.GetProducts(context.Background(), map[string]string{})
+	assert.NoError(t, err)
+	custs, err := c.CustomerManager.GetCustomers(context.Background(), map[string]string{})
+	assert.NoError(t, err)
+	if assert.Len(t, custs, 1) {
+		assert.Equal(t, sharedCommon.ExtraFields{"newField": json.RawMessage(`"value"`)}, custs[0].Extra)
+	}
+
+	assert.Equal(t, []sharedCommon.DriftReport{
+		{Request: "getCustomers", Model: "customers.Customer", Fields: []string{"newField"}},
+	}, reports)
+}
+
+func newRefreshAheadProvider(srv *fakeapi.Server, margin time.Duration, faults ...faultinject.Fault) (*DynamicSessionProvider, *faultinject.Transport) {
+	transport := faultinject.NewTransport(http.DefaultTransport, faults...)
+
+	return &DynamicSessionProvider{
+		ClientCode:    srv.ClientCode,
+		UserName:      "user",
+		Pass:          "pass",
+		URL:           srv.URL,
+		HTTPClient:    transport.Client(),
+		RefreshMargin: margin,
+	}, transport
+}
+
+func TestDynamicSessionProviderRefreshAhead(t *testing.T) {
+	srv := fakeapi.NewServer("123")
+	defer srv.Close()
+	srv.AddUser("user", "pass")
+	srv.SessionLength = 60
+
+	dsp, _ := newRefreshAheadProvider(srv, 30*time.Second)
+	sessionKey, err := dsp.GetSession()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, sessionKey)
+	if assert.NotNil(t, dsp.SessionValidTill) {
+		assert.WithinDuration(t, time.Now().Add(60*time.Second), *dsp.SessionValidTill, 2*time.Second)
+	}
+
+	sameKey, err := dsp.GetSession()
+	assert.NoError(t, err)
+	assert.Equal(t, sessionKey, sameKey)
+	assert.Equal(t, map[string]int{"verifyUser": 1, "getSessionKeyInfo": 1}, srv.RequestCounts())
+
+	slowVerifyUser := faultinject.Fault{Method: "verifyUser", AfterCalls: 1, Latency: 300 * time.Millisecond}
+	dsp, transport := newRefreshAheadProvider(srv, 90*time.Second, slowVerifyUser)
+	oldKey, err := dsp.GetSession()
+	assert.NoError(t, err)
+
+	started := time.Now()
+	currentKey, err := dsp.GetSession()
+	assert.NoError(t, err)
+	assert.Equal(t, oldKey, currentKey)
+	assert.True(t, time.Since(started) < 100*time.Millisecond, "GetSession waited for the background refresh")
+
+	assert.Eventually(t, func() bool {
+		newKey, err := dsp.GetSession()
+		return err == nil && newKey != oldKey
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.True(t, transport.InjectedCounts()[0] >= 1)
+}
+
+func TestDynamicSessionProviderRefreshAheadFailure(t *testing.T) {
+	srv := fakeapi.NewServer("123")
+	defer srv.Close()
+	srv.AddUser("user", "pass")
+	srv.SessionLength = 60
+
+	dsp, transport := newRefreshAheadProvider(
+		srv,
+		90*time.Second,
+		faultinject.Fault{Method: "getSessionKeyInfo", ErrorCode: sharedCommon.ServerMaintenance},
+		faultinject.Fault{Method: "verifyUser", AfterCalls: 1, ErrorCode: sharedCommon.ServerMaintenance},
+	)
+
+	sessionKey, err := dsp.GetSession()
+	assert.NoError(t, err)
+	if assert.NotNil(t, dsp.SessionValidTill) {
+		assert.WithinDuration(t, time.Now().Add(60*time.Second), *dsp.SessionValidTill, 2*time.Second)
+	}
+
+	currentKey, err := dsp.GetSession()
+	assert.NoError(t, err)
+	assert.Equal(t, sessionKey, currentKey)
+
+	assert.Eventually(t, func() bool {
+		dsp.Lock.Lock()
+		defer dsp.Lock.Unlock()
+		return !dsp.refreshing
+	}, 2*time.Second, 10*time.Millisecond)
+
+	currentKey, err = dsp.GetSession()
+	assert.NoError(t, err)
+	assert.Equal(t, sessionKey, currentKey)
+	assert.Equal(t, []int{1, 1}, transport.InjectedCounts())
+}
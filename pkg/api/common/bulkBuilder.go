@@ -0,0 +1,220 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+//CurrentInvoiceID is the special value which refers to the document created by the preceding saveSalesDocument
+//sub-request of the same bulk call
+const CurrentInvoiceID = "CURRENT_INVOICE_ID"
+
+//BulkSender sends bulk sub-requests to the API, it's implemented by the internal client of all managers
+type BulkSender interface {
+	SendRequestBulk(ctx context.Context, inputs []BulkInput, filters map[string]string) (*http.Response, error)
+}
+
+//BulkReference is a symbolic filter value which refers to the result of an earlier sub-request in the same bulk call,
+//the builder checks that the reference can be resolved by the API and replaces it with the special value
+type BulkReference struct {
+	call  *BulkCall
+	value string
+}
+
+//BulkCall is a sub-request added to a BulkBuilder
+type BulkCall struct {
+	index     int
+	requestID string
+	method    string
+	filters   map[string]interface{}
+	result    interface{}
+	status    StatusBulk
+}
+
+//RequestID gives the requestID of the sub-request, it's assigned by the builder if it's not given in the filters
+func (bc *BulkCall) RequestID() string {
+	return bc.requestID
+}
+
+//Status gives the status of the sub-request once the bulk call is sent
+func (bc *BulkCall) Status() StatusBulk {
+	return bc.status
+}
+
+//InvoiceID gives a reference to the id of the document created by this saveSalesDocument sub-request
+func (bc *BulkCall) InvoiceID() BulkReference {
+	return BulkReference{call: bc, value: CurrentInvoiceID}
+}
+
+//BulkBuilder collects dependent sub-requests of one bulk call, e.g. saveSalesDocument followed by savePayment and
+//saveInventoryRegistration which refer to the created document, and decodes each sub-response into its own result
+type BulkBuilder struct {
+	calls []*BulkCall
+}
+
+func NewBulkBuilder() *BulkBuilder {
+	return &BulkBuilder{}
+}
+
+//Add appends a sub-request, the result should be a pointer to the bulk item type of the method
+//(e.g. *sales.SaveSalesDocumentBulkItem) or nil if the sub-response is not needed
+func (bb *BulkBuilder) Add(method string, filters map[string]interface{}, result interface{}) *BulkCall {
+	call := &BulkCall{
+		index:   len(bb.calls),
+		method:  method,
+		filters: filters,
+		result:  result,
+	}
+	if requestID, ok := filters["requestID"]; ok {
+		call.requestID = fmt.Sprint(requestID)
+	}
+	bb.calls = append(bb.calls, call)
+
+	return call
+}
+
+//Build assigns requestIDs, resolves references and gives the inputs for a bulk call
+func (bb *BulkBuilder) Build() ([]BulkInput, error) {
+	hasReferences := false
+	usedRequestIDs := make(map[string]bool, len(bb.calls))
+	for _, call := range bb.calls {
+		if call.requestID == "" {
+			continue
+		}
+		if usedRequestIDs[call.requestID] {
+			return nil, fmt.Errorf("duplicate requestID %s in bulk sub-request %d", call.requestID, call.index)
+		}
+		usedRequestIDs[call.requestID] = true
+	}
+
+	nextRequestID := 1
+	inputs := make([]BulkInput, 0, len(bb.calls))
+	for _, call := range bb.calls {
+		if call.requestID == "" {
+			for usedRequestIDs[strconv.Itoa(nextRequestID)] {
+				nextRequestID++
+			}
+			call.requestID = strconv.Itoa(nextRequestID)
+			usedRequestIDs[call.requestID] = true
+		}
+
+		filters := make(map[string]interface{}, len(call.filters)+1)
+		for key, value := range call.filters {
+			ref, ok := value.(BulkReference)
+			if !ok {
+				filters[key] = value
+				continue
+			}
+			if err := bb.validateReference(call, ref); err != nil {
+				return nil, fmt.Errorf("invalid reference in filter %s of bulk sub-request %d: %v", key, call.index, err)
+			}
+			filters[key] = ref.value
+			hasReferences = true
+		}
+		filters["requestID"] = call.requestID
+
+		inputs = append(inputs, BulkInput{
+			MethodName: call.method,
+			Filters:    filters,
+		})
+	}
+
+	if hasReferences && len(inputs) > MaxBulkRequestsCount {
+		return nil, fmt.Errorf("bulk call with references cannot have more than %d sub-requests", MaxBulkRequestsCount)
+	}
+
+	return inputs, nil
+}
+
+//validateReference checks that the API resolves the reference to the referred call, the special value always
+//refers to the last saveSalesDocument before the referring sub-request
+func (bb *BulkBuilder) validateReference(call *BulkCall, ref BulkReference) error {
+	if ref.call == nil || ref.call.index >= len(bb.calls) || bb.calls[ref.call.index] != ref.call {
+		return fmt.Errorf("the referred sub-request doesn't belong to the builder")
+	}
+	if ref.call.method != "saveSalesDocument" {
+		return fmt.Errorf("%s can refer only to a saveSalesDocument sub-request, got %s", ref.value, ref.call.method)
+	}
+	if ref.call.index >= call.index {
+		return fmt.Errorf("the referred sub-request %d should precede the referring one", ref.call.index)
+	}
+	for _, between := range bb.calls[ref.call.index+1 : call.index] {
+		if between.method == ref.call.method {
+			return fmt.Errorf("sub-request %d replaces the referred document", between.index)
+		}
+	}
+
+	return nil
+}
+
+//Send builds the bulk call, sends it and decodes the sub-responses into the results given to Add,
+//if some of the sub-requests failed BulkError is returned and the results of the successful ones are still decoded
+func (bb *BulkBuilder) Send(ctx context.Context, sender BulkSender, baseFilters map[string]string) error {
+	inputs, err := bb.Build()
+	if err != nil {
+		return err
+	}
+
+	resp, err := sender.SendRequestBulk(ctx, inputs, baseFilters)
+	if err != nil {
+		return err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var bulkResp struct {
+		Status    Status            `json:"status"`
+		BulkItems []json.RawMessage `json:"requests"`
+	}
+	if err := json.Unmarshal(body, &bulkResp); err != nil {
+		return fmt.Errorf("ERPLY API: failed to unmarshal bulk response from '%s': %v", RedactText(string(body)), err)
+	}
+	if !strings.EqualFold(bulkResp.Status.ResponseStatus, "ok") {
+		return NewFromResponseStatus(&bulkResp.Status)
+	}
+
+	callsByRequestID := make(map[string]*BulkCall, len(bb.calls))
+	for _, call := range bb.calls {
+		callsByRequestID[call.requestID] = call
+	}
+
+	bulkErr := &BulkError{}
+	for i, rawItem := range bulkResp.BulkItems {
+		var item struct {
+			Status StatusBulk `json:"status"`
+		}
+		if err := json.Unmarshal(rawItem, &item); err != nil {
+			return fmt.Errorf("ERPLY API: failed to unmarshal bulk sub-response %d from '%s': %v", i, RedactText(string(rawItem)), err)
+		}
+
+		call, ok := callsByRequestID[item.Status.RequestID]
+		if !ok && i < len(bb.calls) {
+			call = bb.calls[i]
+		}
+		if call == nil {
+			return fmt.Errorf("ERPLY API: unexpected bulk sub-response %d with requestID '%s'", i, item.Status.RequestID)
+		}
+
+		call.status = item.Status
+		bulkErr.Add(call.index, item.Status)
+		if call.result == nil {
+			continue
+		}
+		if err := json.Unmarshal(rawItem, call.result); err != nil {
+			return fmt.Errorf("ERPLY API: failed to unmarshal %s sub-response from '%s': %v", call.method, RedactText(string(rawItem)), err)
+		}
+	}
+	if bulkErr.HasFailures() {
+		return bulkErr
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+type bulkSenderMock struct {
+	inputs   []BulkInput
+	respBody string
+}
+
+func (bsm *bulkSenderMock) SendRequestBulk(ctx context.Context, inputs []BulkInput, filters map[string]string) (*http.Response, error) {
+	bsm.inputs = inputs
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(bsm.respBody)),
+	}, nil
+}
+
+type savedDocumentItem struct {
+	Status  StatusBulk `json:"status"`
+	Records []struct {
+		InvoiceID int `json:"invoiceID"`
+	} `json:"records"`
+}
+
+type savedPaymentItem struct {
+	Status  StatusBulk `json:"status"`
+	Records []struct {
+		PaymentID int `json:"paymentID"`
+	} `json:"records"`
+}
+
+func TestBulkBuilderSend(t *testing.T) {
+	bb := NewBulkBuilder()
+
+	doc := &savedDocumentItem{}
+	docCall := bb.Add("saveSalesDocument", map[string]interface{}{"type": "INVWAYBILL"}, doc)
+
+	payment := &savedPaymentItem{}
+	paymentCall := bb.Add("savePayment", map[string]interface{}{"documentID": docCall.InvoiceID(), "sum": 10}, payment)
+
+	registrationCall := bb.Add("saveInventoryRegistration", map[string]interface{}{
+		"requestID":  "registration",
+		"documentID": docCall.InvoiceID(),
+	}, nil)
+
+	sender := &bulkSenderMock{
+		respBody: `{"status":{"responseStatus":"ok"},"requests":[
+			{"status":{"requestName":"saveSalesDocument","requestID":"1","responseStatus":"ok"},"records":[{"invoiceID":123}]},
+			{"status":{"requestName":"savePayment","requestID":"2","responseStatus":"ok"},"records":[{"paymentID":456}]},
+			{"status":{"requestName":"saveInventoryRegistration","requestID":"registration","responseStatus":"error","errorCode":1010,"errorField":"warehouseID"}}
+		]}`,
+	}
+
+	err := bb.Send(context.Background(), sender, map[string]string{})
+
+	bulkErr, ok := err.(*BulkError)
+	assert.True(t, ok)
+	if !ok {
+		return
+	}
+	assert.Equal(t, []int{2}, bulkErr.FailedIndexes())
+
+	assert.Equal(t, []BulkInput{
+		{
+			MethodName: "saveSalesDocument",
+			Filters:    map[string]interface{}{"type": "INVWAYBILL", "requestID": "1"},
+		},
+		{
+			MethodName: "savePayment",
+			Filters:    map[string]interface{}{"documentID": CurrentInvoiceID, "sum": 10, "requestID": "2"},
+		},
+		{
+			MethodName: "saveInventoryRegistration",
+			Filters:    map[string]interface{}{"documentID": CurrentInvoiceID, "requestID": "registration"},
+		},
+	}, sender.inputs)
+
+	assert.Equal(t, "1", docCall.RequestID())
+	assert.Equal(t, "2", paymentCall.RequestID())
+	assert.Equal(t, "registration", registrationCall.RequestID())
+
+	assert.Len(t, doc.Records, 1)
+	assert.Equal(t, 123, doc.Records[0].InvoiceID)
+	assert.Len(t, payment.Records, 1)
+	assert.Equal(t, 456, payment.Records[0].PaymentID)
+	assert.Equal(t, RequiredParamMissing, registrationCall.Status().ErrorCode)
+}
+
+func TestBulkBuilderMatchesByRequestID(t *testing.T) {
+	bb := NewBulkBuilder()
+
+	first := &savedPaymentItem{}
+	bb.Add("savePayment", map[string]interface{}{"sum": 1}, first)
+	second := &savedPaymentItem{}
+	bb.Add("savePayment", map[string]interface{}{"sum": 2}, second)
+
+	sender := &bulkSenderMock{
+		respBody: `{"status":{"responseStatus":"ok"},"requests":[
+			{"status":{"requestID":"2","responseStatus":"ok"},"records":[{"paymentID":2}]},
+			{"status":{"requestID":"1","responseStatus":"ok"},"records":[{"paymentID":1}]}
+		]}`,
+	}
+
+	err := bb.Send(context.Background(), sender, map[string]string{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, first.Records[0].PaymentID)
+	assert.Equal(t, 2, second.Records[0].PaymentID)
+}
+
+func TestBulkBuilderInvalidReferences(t *testing.T) {
+	testCases := []struct {
+		name          string
+		build         func(bb *BulkBuilder)
+		expectedError string
+	}{
+		{
+			name: "not a sales document",
+			build: func(bb *BulkBuilder) {
+				payment := bb.Add("savePayment", map[string]interface{}{}, nil)
+				bb.Add("savePayment", map[string]interface{}{"documentID": payment.InvoiceID()}, nil)
+			},
+			expectedError: "invalid reference in filter documentID of bulk sub-request 1: CURRENT_INVOICE_ID can refer only to a saveSalesDocument sub-request, got savePayment",
+		},
+		{
+			name: "reference to a later call",
+			build: func(bb *BulkBuilder) {
+				payment := bb.Add("savePayment", map[string]interface{}{}, nil)
+				doc := bb.Add("saveSalesDocument", map[string]interface{}{}, nil)
+				payment.filters["documentID"] = doc.InvoiceID()
+			},
+			expectedError: "invalid reference in filter documentID of bulk sub-request 0: the referred sub-request 1 should precede the referring one",
+		},
+		{
+			name: "another document in between",
+			build: func(bb *BulkBuilder) {
+				doc := bb.Add("saveSalesDocument", map[string]interface{}{}, nil)
+				bb.Add("saveSalesDocument", map[string]interface{}{}, nil)
+				bb.Add("savePayment", map[string]interface{}{"documentID": doc.InvoiceID()}, nil)
+			},
+			expectedError: "invalid reference in filter documentID of bulk sub-request 2: sub-request 1 replaces the referred document",
+		},
+		{
+			name: "foreign builder",
+			build: func(bb *BulkBuilder) {
+				doc := NewBulkBuilder().Add("saveSalesDocument", map[string]interface{}{}, nil)
+				bb.Add("savePayment", map[string]interface{}{"documentID": doc.InvoiceID()}, nil)
+			},
+			expectedError: "invalid reference in filter documentID of bulk sub-request 0: the referred sub-request doesn't belong to the builder",
+		},
+		{
+			name: "duplicate request ids",
+			build: func(bb *BulkBuilder) {
+				bb.Add("savePayment", map[string]interface{}{"requestID": 1}, nil)
+				bb.Add("savePayment", map[string]interface{}{"requestID": "1"}, nil)
+			},
+			expectedError: "duplicate requestID 1 in bulk sub-request 1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			bb := NewBulkBuilder()
+			testCase.build(bb)
+
+			_, err := bb.Build()
+			assert.EqualError(t, err, testCase.expectedError)
+		})
+	}
+}
+
+func TestBulkBuilderResponseFailure(t *testing.T) {
+	bb := NewBulkBuilder()
+	bb.Add("savePayment", map[string]interface{}{}, nil)
+
+	respBody, err := json.Marshal(map[string]interface{}{
+		"status": Status{ResponseStatus: "error", Request: "Bulk request", ErrorCode: MalformedRequest},
+	})
+	assert.NoError(t, err)
+
+	err = bb.Send(context.Background(), &bulkSenderMock{respBody: string(respBody)}, map[string]string{})
+	erplyErr, ok := err.(*ErplyError)
+	assert.True(t, ok)
+	if ok {
+		assert.Equal(t, MalformedRequest, erplyErr.Code)
+	}
+}
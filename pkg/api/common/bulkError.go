@@ -0,0 +1,109 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+//BulkItemError describes a failed sub-request of a bulk call
+type BulkItemError struct {
+	//Index is the position of the sub-request in the bulk input
+	Index       int
+	RequestID   string
+	RequestName string
+	Code        ApiError
+	ErrorField  string
+	Status      string
+}
+
+func (bie BulkItemError) String() string {
+	s := fmt.Sprintf("[%d] %s", bie.Index, bie.RequestName)
+	if bie.RequestID != "" {
+		s += fmt.Sprintf(" (requestID: %s)", bie.RequestID)
+	}
+	s += ": " + bie.Code.String()
+	if bie.ErrorField != "" {
+		s += ", error field: " + bie.ErrorField
+	}
+
+	return s
+}
+
+//BulkError is given by bulk methods when some of the sub-requests failed, the response given together with it
+//still contains the results of the successful sub-requests
+type BulkError struct {
+	Failures []BulkItemError
+	//ItemsCount is the total amount of sub-requests in the bulk call
+	ItemsCount int
+}
+
+//NewFromBulkStatuses gives a BulkError with the failed sub-requests of the statuses, it's nil if none of them failed
+func NewFromBulkStatuses(statuses []StatusBulk) error {
+	bulkErr := &BulkError{}
+	for i, status := range statuses {
+		bulkErr.Add(i, status)
+	}
+	if !bulkErr.HasFailures() {
+		return nil
+	}
+
+	return bulkErr
+}
+
+//Add registers the status of the sub-request with the given index, it's ignored if the status is ok
+func (be *BulkError) Add(index int, status StatusBulk) {
+	be.ItemsCount++
+	if strings.EqualFold(status.ResponseStatus, "ok") {
+		return
+	}
+
+	be.Failures = append(be.Failures, BulkItemError{
+		Index:       index,
+		RequestID:   status.RequestID,
+		RequestName: status.RequestName,
+		Code:        status.ErrorCode,
+		ErrorField:  status.ErrorField,
+		Status:      status.ResponseStatus,
+	})
+}
+
+//HasFailures tells if any of the added sub-requests failed
+func (be *BulkError) HasFailures() bool {
+	return len(be.Failures) > 0
+}
+
+//FailedIndexes gives the positions of the failed sub-requests in the bulk input
+func (be *BulkError) FailedIndexes() []int {
+	indexes := make([]int, 0, len(be.Failures))
+	for _, failure := range be.Failures {
+		indexes = append(indexes, failure.Index)
+	}
+
+	return indexes
+}
+
+func (be *BulkError) Error() string {
+	failures := make([]string, 0, len(be.Failures))
+	for _, failure := range be.Failures {
+		failures = append(failures, failure.String())
+	}
+
+	return fmt.Sprintf(
+		"ERPLY API: %d of %d bulk sub-requests failed: %s",
+		len(be.Failures),
+		be.ItemsCount,
+		strings.Join(failures, "; "),
+	)
+}
+
+//Is tells if any of the failed sub-requests belongs to the category like ErrValidation, see ErplyError.Is
+func (be *BulkError) Is(target error) bool {
+	for _, failure := range be.Failures {
+		failureErr := &ErplyError{Code: failure.Code, ErrorField: failure.ErrorField}
+		if failureErr.Is(target) {
+			return true
+		}
+	}
+
+	return false
+}
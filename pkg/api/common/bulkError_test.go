@@ -0,0 +1,68 @@
+package common
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBulkError(t *testing.T) {
+	bulkErr := &BulkError{}
+
+	okStatus := StatusBulk{RequestName: "saveProduct", RequestID: "1"}
+	okStatus.ResponseStatus = "ok"
+	bulkErr.Add(0, okStatus)
+	assert.False(t, bulkErr.HasFailures())
+
+	failedStatus := StatusBulk{RequestName: "saveProduct", RequestID: "2"}
+	failedStatus.ResponseStatus = "error"
+	failedStatus.ErrorCode = RequiredParamMissing
+	failedStatus.ErrorField = "groupID"
+	bulkErr.Add(1, failedStatus)
+
+	failedStatusWithoutID := StatusBulk{RequestName: "saveProduct"}
+	failedStatusWithoutID.ResponseStatus = "error"
+	failedStatusWithoutID.ErrorCode = InvalidValue
+	bulkErr.Add(2, failedStatusWithoutID)
+
+	assert.True(t, bulkErr.HasFailures())
+	assert.Equal(t, 3, bulkErr.ItemsCount)
+	assert.Equal(t, []int{1, 2}, bulkErr.FailedIndexes())
+	assert.Equal(t, BulkItemError{
+		Index:       1,
+		RequestID:   "2",
+		RequestName: "saveProduct",
+		Code:        RequiredParamMissing,
+		ErrorField:  "groupID",
+		Status:      "error",
+	}, bulkErr.Failures[0])
+
+	assert.EqualError(
+		t,
+		bulkErr,
+		"ERPLY API: 2 of 3 bulk sub-requests failed: "+
+			"[1] saveProduct (requestID: 2): "+RequiredParamMissing.String()+", error field: groupID; "+
+			"[2] saveProduct: "+InvalidValue.String(),
+	)
+
+	assert.True(t, errors.Is(bulkErr, ErrValidation))
+	assert.False(t, errors.Is(bulkErr, ErrAuth))
+}
+
+func TestNewFromBulkStatuses(t *testing.T) {
+	okStatus := StatusBulk{RequestName: "saveProduct"}
+	okStatus.ResponseStatus = "ok"
+	assert.NoError(t, NewFromBulkStatuses([]StatusBulk{okStatus, okStatus}))
+	assert.NoError(t, NewFromBulkStatuses(nil))
+
+	failedStatus := StatusBulk{RequestName: "saveProduct"}
+	failedStatus.ResponseStatus = "error"
+	failedStatus.ErrorCode = RequiredParamMissing
+
+	err := NewFromBulkStatuses([]StatusBulk{okStatus, failedStatus})
+	bulkErr := &BulkError{}
+	if assert.True(t, errors.As(err, &bulkErr)) {
+		assert.Equal(t, 2, bulkErr.ItemsCount)
+		assert.Equal(t, []int{1}, bulkErr.FailedIndexes())
+	}
+}
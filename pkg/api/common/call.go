@@ -0,0 +1,59 @@
+package common
+
+import (
+	"encoding/json"
+)
+
+//DestRespWithStatus is a destination for the response of a single API call, the status is used to detect API errors
+type DestRespWithStatus interface {
+	GetStatus() *Status
+}
+
+//BulkItemResponse holds the raw result of a bulk sub-request
+type BulkItemResponse struct {
+	Status StatusBulk
+	//Records is the raw JSON of the records field of the sub-response
+	Records json.RawMessage
+	//Raw is the raw JSON of the whole sub-response
+	Raw json.RawMessage
+}
+
+//Decode unmarshals the whole sub-response into dest, e.g. into a bulk item type of a manager
+func (bir BulkItemResponse) Decode(dest interface{}) error {
+	return json.Unmarshal(bir.Raw, dest)
+}
+
+//BulkResponse holds the raw results of a bulk call in the order of its inputs
+type BulkResponse struct {
+	Status    Status
+	BulkItems []BulkItemResponse
+}
+
+func (br *BulkResponse) UnmarshalJSON(data []byte) error {
+	var rawResp struct {
+		Status   Status            `json:"status"`
+		Requests []json.RawMessage `json:"requests"`
+	}
+	if err := json.Unmarshal(data, &rawResp); err != nil {
+		return err
+	}
+
+	br.Status = rawResp.Status
+	br.BulkItems = make([]BulkItemResponse, 0, len(rawResp.Requests))
+	for _, rawItem := range rawResp.Requests {
+		var item struct {
+			Status  StatusBulk      `json:"status"`
+			Records json.RawMessage `json:"records"`
+		}
+		if err := json.Unmarshal(rawItem, &item); err != nil {
+			return err
+		}
+		br.BulkItems = append(br.BulkItems, BulkItemResponse{
+			Status:  item.Status,
+			Records: item.Records,
+			Raw:     rawItem,
+		})
+	}
+
+	return nil
+}
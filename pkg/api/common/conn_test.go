@@ -56,7 +56,7 @@ func TestSessionExpiration(t *testing.T) {
 		Connect: func() (err error) {
 			if connectAmount == 0 {
 				err = &ErplyError{
-					error:   errors.New("conn failure"),
+					Err:     errors.New("conn failure"),
 					Status:  "Some status",
 					Message: "Some message",
 					Code:    APISessionExpired,
@@ -126,7 +126,7 @@ func TestSessionCleanFailure(t *testing.T) {
 		Connect: func() (err error) {
 			if connectAmount == 0 {
 				err = &ErplyError{
-					error:   errors.New("conn failure"),
+					Err:     errors.New("conn failure"),
 					Status:  "Some status",
 					Message: "Some message",
 					Code:    APISessionExpired,
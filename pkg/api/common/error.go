@@ -339,15 +339,39 @@ func (s ApiError) String() string {
 	return fmt.Sprintf("[%d] %s", int(s), strVal)
 }
 
+//ErplyError is given when the API reports a failure or when a request cannot be sent or decoded,
+//use errors.Is with the categories like ErrAuth or ErrValidation to tell the failures apart
 type ErplyError struct {
-	error
 	Status  string
 	Message string
 	Code    ApiError
+	//Request is the name of the failed ERPLY request if it's known
+	Request string
+	//ErrorField is the input parameter which caused the failure if the API reported it
+	ErrorField string
+	//Err is the underlying cause e.g. a network or decoding error, it's given by Unwrap
+	Err error
 }
 
+//Error gives the error description where the values of SensitiveParams are masked
 func (e *ErplyError) Error() string {
-	return fmt.Sprintf("ERPLY API: %s, status: %s, code: %d", e.Message, e.Status, e.Code)
+	return RedactText(fmt.Sprintf("ERPLY API: %s, status: %s, code: %d", e.Message, e.Status, e.Code))
+}
+
+func (e *ErplyError) Unwrap() error {
+	return e.Err
+}
+
+//Is tells if the error belongs to one of the categories like ErrAuth, ErrQuota or ErrValidation
+func (e *ErplyError) Is(target error) bool {
+	if e.Code == 0 {
+		return false
+	}
+	if target == ErrValidation && e.ErrorField != "" && len(e.Code.Categories()) == 0 {
+		return true
+	}
+
+	return e.Code.Is(target)
 }
 
 func NewErplyError(status, msg string, code ApiError) *ErplyError {
@@ -366,13 +390,20 @@ func NewFromResponseStatus(status *Status) *ErplyError {
 		s = status.ErrorCode.String()
 	}
 	m := status.Request + ": " + status.ResponseStatus
-	return &ErplyError{Status: s, Message: m, Code: status.ErrorCode}
+	return &ErplyError{
+		Status:     s,
+		Message:    m,
+		Code:       status.ErrorCode,
+		Request:    status.Request,
+		ErrorField: status.ErrorField,
+	}
 }
 
 func NewFromError(msg string, err error, code ApiError) *ErplyError {
 	if err != nil {
-		return NewErplyError("Error", errors.Wrap(err, msg).Error(), code)
+		erplyErr := NewErplyError("Error", errors.Wrap(err, msg).Error(), code)
+		erplyErr.Err = err
+		return erplyErr
 	}
 	return NewErplyError("Error", msg, code)
 }
-
@@ -0,0 +1,125 @@
+package common
+
+import (
+	"errors"
+)
+
+//The categories of the API error codes, they are matched with errors.Is by ErplyError and BulkError,
+//e.g. errors.Is(err, ErrAuth). A code can belong to several categories
+var (
+	//ErrAuth is for failed logins, missing, expired or invalid sessions and JWTs
+	ErrAuth = errors.New("ERPLY API: authentication failed")
+	//ErrPermission is for the requests which the user or the account is not allowed to make
+	ErrPermission = errors.New("ERPLY API: permission denied")
+	//ErrQuota is for the requests rejected because of the hourly request quota
+	ErrQuota = errors.New("ERPLY API: request quota exceeded")
+	//ErrValidation is for missing, invalid or conflicting input parameters
+	ErrValidation = errors.New("ERPLY API: invalid input")
+	//ErrNotFound is for the requests which refer to records that don't exist
+	ErrNotFound = errors.New("ERPLY API: not found")
+	//ErrMaintenance is for the requests which failed because the API or the account database is temporarily unavailable
+	ErrMaintenance = errors.New("ERPLY API: temporarily unavailable")
+	//ErrRetryable is for the failures which might succeed when repeated later, these are the DefaultRetryableCodes
+	//and the quota errors
+	ErrRetryable = errors.New("ERPLY API: retryable failure")
+)
+
+var errorCategories = map[error][]ApiError{
+	ErrAuth: {
+		AccountNotFound,
+		MissingAuth,
+		AuthMissing,
+		LoginFailed,
+		UserBlocked,
+		MissingSavedPassword,
+		APISessionExpired,
+		InvalidSession,
+		SessionTooOld,
+		DemoAccountExpired,
+		PinLoginNotSupported,
+		AccountNotConfirmed,
+		CustomerCodeMissingInJWT,
+		MissingUsernameInJWT,
+		MissingUserName,
+		PendingStatusForUser,
+		NotPossibleToExtendSessionForJWT,
+		WrongJWTAccount,
+		JWTDecodingFailure,
+		JWTExpired,
+	},
+	ErrPermission: {
+		ApiNotAvailable,
+		NoUserGroupDetected,
+		NoViewingRights,
+		NoAddingRights,
+		NoEditingRights,
+		NoDeletingRights,
+		NoLocationAccess,
+		NoAPIAccess,
+		NoGroupManagementRights,
+		WrongAccountFranchise,
+		AccountLimitationOnIntegration,
+		NoAccessToCustomerData,
+		NoRightsForBackOffice,
+	},
+	ErrQuota: {
+		HourlyRequestQuota,
+	},
+	ErrValidation: {
+		UnknownApi,
+		UnknownOutputFormat,
+		RequiredParamMissing,
+		InvalidClassifierID,
+		ParamIsNotUnique,
+		InconsistentParam,
+		InvalidFormat,
+		MalformedRequest,
+		InvalidValue,
+		MultipleMatchesFound,
+		TooManyBulkSubRequests,
+		WrongRowsSequence,
+		IdenticalRecordExists,
+		NotAllowedToChangeValue,
+		NotUsableField,
+		IncorrectList,
+		ArrayValueRequired,
+		PasswordLengthFailure,
+		WrongLettersInPassword,
+		PasswordComplexityError,
+		AccountInputFieldIsNotAllowed,
+		IntegrationSpecificFieldMissingInputParameter,
+		ValueLengthError,
+		TooLongListOfElements,
+		UsernameAlreadyExists,
+		DateIsNotFutureError,
+		WrongLanguageCode,
+		NotNewPassword,
+	},
+	ErrNotFound: {
+		InvalidClassifierID,
+		NoRecordsFound,
+		NoEmployeeRecord,
+	},
+	ErrMaintenance: {
+		ServerMaintenance,
+		AccountDbConnError,
+	},
+	ErrRetryable: append(append([]ApiError{}, DefaultRetryableCodes...), HourlyRequestQuota),
+}
+
+//Categories gives the categories of the code like ErrAuth or ErrValidation, it's empty for the codes without a category
+func (s ApiError) Categories() []error {
+	var categories []error
+	for _, category := range []error{ErrAuth, ErrPermission, ErrQuota, ErrValidation, ErrNotFound, ErrMaintenance, ErrRetryable} {
+		if s.Is(category) {
+			categories = append(categories, category)
+		}
+	}
+
+	return categories
+}
+
+//Is tells if the code belongs to the category
+func (s ApiError) Is(category error) bool {
+	return containsCode(errorCategories[category], s)
+}
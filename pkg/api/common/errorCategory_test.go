@@ -0,0 +1,87 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestErplyErrorCategories(t *testing.T) {
+	testCases := []struct {
+		err        error
+		categories []error
+	}{
+		{
+			err:        NewFromResponseStatus(&Status{Request: "getProducts", ResponseStatus: "error", ErrorCode: APISessionExpired}),
+			categories: []error{ErrAuth},
+		},
+		{
+			err:        NewFromResponseStatus(&Status{Request: "saveProduct", ResponseStatus: "error", ErrorCode: NoAddingRights}),
+			categories: []error{ErrPermission},
+		},
+		{
+			err:        NewFromResponseStatus(&Status{Request: "getProducts", ResponseStatus: "error", ErrorCode: HourlyRequestQuota}),
+			categories: []error{ErrQuota, ErrRetryable},
+		},
+		{
+			err:        NewFromResponseStatus(&Status{Request: "saveProduct", ResponseStatus: "error", ErrorCode: InvalidClassifierID, ErrorField: "groupID"}),
+			categories: []error{ErrValidation, ErrNotFound},
+		},
+		{
+			err:        NewFromResponseStatus(&Status{Request: "getProducts", ResponseStatus: "error", ErrorCode: ServerMaintenance}),
+			categories: []error{ErrMaintenance, ErrRetryable},
+		},
+		{
+			err:        NewFromResponseStatus(&Status{Request: "saveProduct", ResponseStatus: "error", ErrorCode: MasterListLimitation, ErrorField: "code"}),
+			categories: []error{ErrValidation},
+		},
+		{
+			err:        NewFromResponseStatus(&Status{Request: "saveProduct", ResponseStatus: "error", ErrorCode: MasterListLimitation}),
+			categories: nil,
+		},
+		{
+			err:        fmt.Errorf("wrapped: %w", NewErplyError("Error", "some message", DbError)),
+			categories: []error{ErrRetryable},
+		},
+	}
+
+	allCategories := []error{ErrAuth, ErrPermission, ErrQuota, ErrValidation, ErrNotFound, ErrMaintenance, ErrRetryable}
+	for _, testCase := range testCases {
+		for _, category := range allCategories {
+			expected := false
+			for _, expectedCategory := range testCase.categories {
+				if expectedCategory == category {
+					expected = true
+				}
+			}
+			assert.Equal(t, expected, errors.Is(testCase.err, category), "%v is %v", testCase.err, category)
+		}
+	}
+}
+
+func TestErplyErrorFields(t *testing.T) {
+	err := NewFromResponseStatus(&Status{Request: "saveProduct", ResponseStatus: "error", ErrorCode: InvalidValue, ErrorField: "price"})
+	assert.Equal(t, "saveProduct", err.Request)
+	assert.Equal(t, "price", err.ErrorField)
+	assert.Nil(t, err.Unwrap())
+
+	var erplyErr *ErplyError
+	assert.True(t, errors.As(fmt.Errorf("wrapped: %w", err), &erplyErr))
+	assert.Equal(t, InvalidValue, erplyErr.Code)
+}
+
+func TestErplyErrorUnwrap(t *testing.T) {
+	err := NewFromError("getProducts request failed", context.DeadlineExceeded, 0)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, context.DeadlineExceeded, errors.Unwrap(err))
+	assert.False(t, errors.Is(err, ErrRetryable))
+}
+
+func TestApiErrorCategories(t *testing.T) {
+	assert.Equal(t, []error{ErrQuota, ErrRetryable}, HourlyRequestQuota.Categories())
+	assert.Nil(t, MasterListLimitation.Categories())
+	assert.True(t, JWTExpired.Is(ErrAuth))
+	assert.False(t, JWTExpired.Is(ErrValidation))
+}
@@ -0,0 +1,23 @@
+package common
+
+import (
+	"expvar"
+	"time"
+)
+
+//ExpvarMetrics is InMemoryMetrics which is exported as an expvar variable, so the numbers are served
+//as JSON by the /debug/vars handler of the expvar package
+type ExpvarMetrics struct {
+	*InMemoryMetrics
+}
+
+//NewExpvarMetrics publishes the snapshot of new InMemoryMetrics under the name, like expvar.Publish it panics
+//if the name is already in use, so create it once per process and share it between the clients
+func NewExpvarMetrics(name string, latencyBuckets ...time.Duration) *ExpvarMetrics {
+	metrics := &ExpvarMetrics{InMemoryMetrics: NewInMemoryMetrics(latencyBuckets...)}
+	expvar.Publish(name, expvar.Func(func() interface{} {
+		return metrics.Snapshot()
+	}))
+
+	return metrics
+}
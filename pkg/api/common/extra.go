@@ -0,0 +1,250 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//ExtraFields holds the raw values of the response fields which are not declared in a model
+type ExtraFields map[string]json.RawMessage
+
+//DecodeExtraFields sets the Extra maps of the records to the fields of the records array in the response body which are
+//not declared in the model. records is the decoded slice of the models, e.g. []products.Product, the models must have
+//an Extra field of the ExtraFields type. The clients call it only if ClientBuilder.KeepExtraFields is enabled
+func DecodeExtraFields(body []byte, records interface{}) error {
+	recordsValue := reflect.ValueOf(records)
+	if recordsValue.Kind() != reflect.Slice {
+		return fmt.Errorf("cannot decode extra fields into %T, a slice is expected", records)
+	}
+
+	extraIndex, ok := extraFieldIndex(recordsValue.Type().Elem())
+	if !ok {
+		return fmt.Errorf("cannot decode extra fields into %T, the model has no Extra field", records)
+	}
+
+	var envelope struct {
+		Records []json.RawMessage `json:"records"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return err
+	}
+
+	model := reflect.Zero(recordsValue.Type().Elem()).Interface()
+	for i, record := range envelope.Records {
+		if i >= recordsValue.Len() {
+			break
+		}
+		fields, err := UndeclaredFields(record, model)
+		if err != nil {
+			return err
+		}
+		recordsValue.Index(i).Field(extraIndex).Set(reflect.ValueOf(fields))
+	}
+
+	return nil
+}
+
+//DecodeBulkExtraFields is DecodeExtraFields for the sub-responses of a bulk response body,
+//records gives the decoded records slice of the sub-response with the index
+func DecodeBulkExtraFields(body []byte, records func(i int) interface{}) error {
+	var bulkResp struct {
+		Requests []json.RawMessage `json:"requests"`
+	}
+	if err := json.Unmarshal(body, &bulkResp); err != nil {
+		return err
+	}
+
+	for i, item := range bulkResp.Requests {
+		if err := DecodeExtraFields(item, records(i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var extraFieldsType = reflect.TypeOf(ExtraFields{})
+
+//extraFieldIndex gives the index of the Extra field of the model struct
+func extraFieldIndex(modelType reflect.Type) (int, bool) {
+	if modelType.Kind() != reflect.Struct {
+		return 0, false
+	}
+
+	field, ok := modelType.FieldByName("Extra")
+	if !ok || field.Type != extraFieldsType || len(field.Index) != 1 {
+		return 0, false
+	}
+
+	return field.Index[0], true
+}
+
+//UndeclaredFields gives the fields of the JSON object which are not declared in the model struct,
+//the names are matched case-insensitively like encoding/json does
+func UndeclaredFields(data []byte, model interface{}) (ExtraFields, error) {
+	var rawFields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawFields); err != nil {
+		return nil, err
+	}
+
+	declared := declaredFields(reflect.TypeOf(model))
+	var fields ExtraFields
+	for name, value := range rawFields {
+		if declared[strings.ToLower(name)] {
+			continue
+		}
+		if fields == nil {
+			fields = ExtraFields{}
+		}
+		fields[name] = value
+	}
+
+	return fields, nil
+}
+
+var declaredFieldsCache sync.Map
+
+//declaredFields gives the lower cased JSON names of the struct fields including the ones of the embedded structs
+func declaredFields(modelType reflect.Type) map[string]bool {
+	for modelType != nil && modelType.Kind() == reflect.Ptr {
+		modelType = modelType.Elem()
+	}
+	if modelType == nil || modelType.Kind() != reflect.Struct {
+		return map[string]bool{}
+	}
+
+	if cached, ok := declaredFieldsCache.Load(modelType); ok {
+		return cached.(map[string]bool)
+	}
+
+	fields := map[string]bool{}
+	collectDeclaredFields(modelType, fields)
+	declaredFieldsCache.Store(modelType, fields)
+
+	return fields
+}
+
+func collectDeclaredFields(modelType reflect.Type, fields map[string]bool) {
+	for i := 0; i < modelType.NumField(); i++ {
+		field := modelType.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			collectDeclaredFields(fieldType, fields)
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[strings.ToLower(name)] = true
+	}
+}
+
+//DriftReport describes the fields of a response which are not declared in the model of its records
+type DriftReport struct {
+	//Request is the ERPLY request name, e.g. getProducts
+	Request string
+	//Model is the Go type of the records, e.g. products.Product
+	Model string
+	//Fields are the sorted names of the undeclared fields found in any of the records
+	Fields []string
+}
+
+//DriftHandler receives the reports of the drift detector, it's called synchronously for every response with
+//undeclared fields so it should be fast
+type DriftHandler func(ctx context.Context, report DriftReport)
+
+//NewDriftDetector gives a middleware which compares the records of the successful responses with the models given
+//for the request names and reports the undeclared fields to the handler, the responses of other requests are ignored.
+//The models are struct values like products.Product{}, bulk sub-requests are checked separately
+func NewDriftDetector(models map[string]interface{}, handler DriftHandler) Middleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			resp, err := next(ctx, req)
+			if err != nil || resp == nil || handler == nil {
+				return resp, err
+			}
+
+			if !req.IsBulk() {
+				if report, ok := detectDrift(req.Method, resp.Body, models); ok {
+					handler(ctx, report)
+				}
+				return resp, err
+			}
+
+			var bulkResp struct {
+				Requests []json.RawMessage `json:"requests"`
+			}
+			if json.Unmarshal(resp.Body, &bulkResp) != nil {
+				return resp, err
+			}
+			for i, item := range bulkResp.Requests {
+				if i >= len(req.BulkInputs) {
+					break
+				}
+				if report, ok := detectDrift(req.BulkInputs[i].MethodName, item, models); ok {
+					handler(ctx, report)
+				}
+			}
+
+			return resp, err
+		}
+	}
+}
+
+//detectDrift gives the report for the records of the response body if the request has a model and some fields are undeclared
+func detectDrift(request string, body []byte, models map[string]interface{}) (DriftReport, bool) {
+	model, ok := models[request]
+	if !ok {
+		return DriftReport{}, false
+	}
+
+	var envelope struct {
+		Records []json.RawMessage `json:"records"`
+	}
+	if json.Unmarshal(body, &envelope) != nil {
+		return DriftReport{}, false
+	}
+
+	undeclared := map[string]bool{}
+	for _, record := range envelope.Records {
+		fields, err := UndeclaredFields(record, model)
+		if err != nil {
+			continue
+		}
+		for name := range fields {
+			undeclared[name] = true
+		}
+	}
+	if len(undeclared) == 0 {
+		return DriftReport{}, false
+	}
+
+	report := DriftReport{
+		Request: request,
+		Model:   fmt.Sprintf("%T", model),
+		Fields:  make([]string, 0, len(undeclared)),
+	}
+	for name := range undeclared {
+		report.Fields = append(report.Fields, name)
+	}
+	sort.Strings(report.Fields)
+
+	return report, true
+}
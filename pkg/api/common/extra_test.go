@@ -0,0 +1,112 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type extraTestModel struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Code string
+	Skip string `json:"-"`
+	LastModified
+	Attributes
+}
+
+func TestUndeclaredFields(t *testing.T) {
+	fields, err := UndeclaredFields([]byte(`{
+		"id":1,
+		"NAME":"some",
+		"code":"abc",
+		"lastModified":123,
+		"attributes":[],
+		"skip":"x",
+		"newField":{"a":1}
+	}`), extraTestModel{})
+	assert.NoError(t, err)
+	assert.Equal(t, ExtraFields{
+		"skip":     json.RawMessage(`"x"`),
+		"newField": json.RawMessage(`{"a":1}`),
+	}, fields)
+
+	fields, err = UndeclaredFields([]byte(`{"id":1}`), &extraTestModel{})
+	assert.NoError(t, err)
+	assert.Nil(t, fields)
+
+	_, err = UndeclaredFields([]byte(`[1]`), extraTestModel{})
+	assert.Error(t, err)
+}
+
+type extraTestRecord struct {
+	ID    int         `json:"id"`
+	Extra ExtraFields `json:"-"`
+}
+
+func TestDecodeExtraFields(t *testing.T) {
+	body := []byte(`{"status":{"responseStatus":"ok"},"records":[{"id":1,"newField":"value"},{"id":2}]}`)
+
+	records := []extraTestRecord{{ID: 1}, {ID: 2, Extra: ExtraFields{"old": json.RawMessage(`1`)}}}
+	assert.NoError(t, DecodeExtraFields(body, records))
+	assert.Equal(t, []extraTestRecord{
+		{ID: 1, Extra: ExtraFields{"newField": json.RawMessage(`"value"`)}},
+		{ID: 2},
+	}, records)
+
+	assert.Error(t, DecodeExtraFields(body, extraTestRecord{}))
+	assert.Error(t, DecodeExtraFields(body, []extraTestModel{}))
+}
+
+func TestDecodeBulkExtraFields(t *testing.T) {
+	body := []byte(`{"status":{"responseStatus":"ok"},"requests":[
+		{"status":{"responseStatus":"ok"},"records":[{"id":1,"a":1}]},
+		{"status":{"responseStatus":"ok"},"records":[{"id":2},{"id":3,"b":2}]}
+	]}`)
+
+	items := [][]extraTestRecord{{{ID: 1}}, {{ID: 2}, {ID: 3}}}
+	assert.NoError(t, DecodeBulkExtraFields(body, func(i int) interface{} {
+		return items[i]
+	}))
+	assert.Equal(t, [][]extraTestRecord{
+		{{ID: 1, Extra: ExtraFields{"a": json.RawMessage(`1`)}}},
+		{{ID: 2}, {ID: 3, Extra: ExtraFields{"b": json.RawMessage(`2`)}}},
+	}, items)
+}
+
+func TestDriftDetector(t *testing.T) {
+	reports := []DriftReport{}
+	detector := NewDriftDetector(
+		map[string]interface{}{"getModels": extraTestModel{}},
+		func(ctx context.Context, report DriftReport) {
+			reports = append(reports, report)
+		},
+	)
+
+	body := `{"status":{"responseStatus":"ok"},"records":[{"id":1,"b":2},{"id":2,"a":1}]}`
+	handler := ChainMiddlewares(
+		func(ctx context.Context, req *Request) (*Response, error) {
+			if req.IsBulk() {
+				return &Response{Body: []byte(`{"status":{"responseStatus":"ok"},"requests":[` + body + `,` + body + `]}`)}, nil
+			}
+			return &Response{Body: []byte(body)}, nil
+		},
+		detector,
+	)
+
+	_, err := handler(context.Background(), &Request{Method: "getModels"})
+	assert.NoError(t, err)
+	_, err = handler(context.Background(), &Request{Method: "getOthers"})
+	assert.NoError(t, err)
+	_, err = handler(context.Background(), &Request{BulkInputs: []BulkInput{
+		{MethodName: "getOthers"},
+		{MethodName: "getModels"},
+	}})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []DriftReport{
+		{Request: "getModels", Model: "common.extraTestModel", Fields: []string{"a", "b"}},
+		{Request: "getModels", Model: "common.extraTestModel", Fields: []string{"a", "b"}},
+	}, reports)
+}
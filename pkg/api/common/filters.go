@@ -0,0 +1,217 @@
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//FilterValuer is implemented by types which have a custom encoding in request filters,
+//an empty value means that the filter is not sent
+type FilterValuer interface {
+	FilterValue() string
+}
+
+var (
+	filterValuerType = reflect.TypeOf((*FilterValuer)(nil)).Elem()
+	timeType         = reflect.TypeOf(time.Time{})
+)
+
+//Pagination is embedded into the typed filters of the requests which support pagination
+type Pagination struct {
+	RecordsOnPage int `json:"recordsOnPage"`
+	PageNo        int `json:"pageNo"`
+}
+
+//EncodeFilters converts a typed filter struct or save input to the request parameters, the parameter names are taken
+//from the json tags. Zero values are not sent, use pointer fields to send them explicitly. Booleans are encoded as 0 or 1,
+//time values as unix timestamps and slices as comma separated lists. Slices of structs tagged with the indexed option
+//are encoded as numbered rows, e.g. the Rows field tagged `json:"rows,indexed"` gives productID1, amount1, productID2...
+func EncodeFilters(input interface{}) (map[string]string, error) {
+	filters := map[string]string{}
+
+	val := reflect.ValueOf(input)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return filters, nil
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("filters should be a struct, got %s", val.Kind())
+	}
+
+	if err := encodeFilterStruct(val, filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
+
+//EncodeBulkFilters converts a typed filter struct or save input to the filters of a bulk sub-request
+func EncodeBulkFilters(input interface{}) (map[string]interface{}, error) {
+	filters, err := EncodeFilters(input)
+	if err != nil {
+		return nil, err
+	}
+
+	bulkFilters := make(map[string]interface{}, len(filters))
+	for key, value := range filters {
+		bulkFilters[key] = value
+	}
+
+	return bulkFilters, nil
+}
+
+//walkStructFields calls visit for the exported fields of the struct with their json names and tag options,
+//the fields of the embedded structs without a name are visited as the own fields
+func walkStructFields(val reflect.Value, visit func(field reflect.StructField, name string, options []string, fieldVal reflect.Value) error) error {
+	valType := val.Type()
+	for i := 0; i < valType.NumField(); i++ {
+		field := valType.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+
+		tagParts := strings.Split(field.Tag.Get("json"), ",")
+		name := tagParts[0]
+		if name == "-" {
+			continue
+		}
+
+		fieldVal := val.Field(i)
+		if field.Anonymous && name == "" && fieldVal.Kind() == reflect.Struct && !fieldVal.Type().Implements(filterValuerType) {
+			if err := walkStructFields(fieldVal, visit); err != nil {
+				return err
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		if err := visit(field, name, tagParts[1:], fieldVal); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeFilterStruct(val reflect.Value, filters map[string]string) error {
+	return walkStructFields(val, func(field reflect.StructField, name string, options []string, fieldVal reflect.Value) error {
+		if hasTagOption(options, "indexed") {
+			if err := encodeIndexedRows(fieldVal, filters); err != nil {
+				return fmt.Errorf("cannot encode rows %s: %v", field.Name, err)
+			}
+			return nil
+		}
+
+		value, ok, err := encodeFilterValue(fieldVal)
+		if err != nil {
+			return fmt.Errorf("cannot encode filter %s: %v", name, err)
+		}
+		if ok {
+			filters[name] = value
+		}
+
+		return nil
+	})
+}
+
+//encodeIndexedRows encodes each struct of the slice with the row number suffix, e.g. productID1, amount1, productID2...
+func encodeIndexedRows(val reflect.Value, filters map[string]string) error {
+	if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+		return fmt.Errorf("indexed rows should be a slice, got %s", val.Kind())
+	}
+
+	for i := 0; i < val.Len(); i++ {
+		row := val.Index(i)
+		for row.Kind() == reflect.Ptr && !row.IsNil() {
+			row = row.Elem()
+		}
+		if row.Kind() != reflect.Struct {
+			return fmt.Errorf("indexed row should be a struct, got %s", row.Kind())
+		}
+
+		rowFilters := map[string]string{}
+		if err := encodeFilterStruct(row, rowFilters); err != nil {
+			return err
+		}
+		for key, value := range rowFilters {
+			filters[key+strconv.Itoa(i+1)] = value
+		}
+	}
+
+	return nil
+}
+
+func hasTagOption(options []string, option string) bool {
+	for _, opt := range options {
+		if opt == option {
+			return true
+		}
+	}
+
+	return false
+}
+
+//encodeFilterValue gives the encoded value and false if the filter should not be sent
+func encodeFilterValue(val reflect.Value) (string, bool, error) {
+	if val.Type().Implements(filterValuerType) {
+		if val.Kind() == reflect.Ptr && val.IsNil() {
+			return "", false, nil
+		}
+		value := val.Interface().(FilterValuer).FilterValue()
+		return value, value != "", nil
+	}
+
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return "", false, nil
+		}
+		value, _, err := encodeFilterValue(val.Elem())
+		return value, true, err
+	}
+
+	if val.Type() == timeType {
+		t := val.Interface().(time.Time)
+		if t.IsZero() {
+			return "", false, nil
+		}
+		return strconv.FormatInt(t.Unix(), 10), true, nil
+	}
+
+	switch val.Kind() {
+	case reflect.String:
+		return val.String(), val.Len() > 0, nil
+	case reflect.Bool:
+		if val.Bool() {
+			return "1", true, nil
+		}
+		return "0", false, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(val.Int(), 10), val.Int() != 0, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(val.Uint(), 10), val.Uint() != 0, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(val.Float(), 'f', -1, 64), val.Float() != 0, nil
+	case reflect.Slice, reflect.Array:
+		items := make([]string, 0, val.Len())
+		for i := 0; i < val.Len(); i++ {
+			item, _, err := encodeFilterValue(val.Index(i))
+			if err != nil {
+				return "", false, err
+			}
+			items = append(items, item)
+		}
+		return strings.Join(items, ","), len(items) > 0, nil
+	}
+
+	return "", false, fmt.Errorf("unsupported type %s", val.Type())
+}
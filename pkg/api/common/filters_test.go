@@ -0,0 +1,121 @@
+package common
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type testFilterValue string
+
+func (tfv testFilterValue) FilterValue() string {
+	if tfv == "" {
+		return ""
+	}
+	return "custom:" + string(tfv)
+}
+
+type testFilters struct {
+	ID           int             `json:"id"`
+	IDs          []int           `json:"ids"`
+	Types        []string        `json:"types"`
+	Name         string          `json:"name"`
+	Price        float64         `json:"price"`
+	GetStock     bool            `json:"getStock"`
+	Active       *bool           `json:"active"`
+	Deleted      *bool           `json:"deleted"`
+	ChangedSince time.Time       `json:"changedSince"`
+	Custom       testFilterValue `json:"custom"`
+	Ignored      string          `json:"-"`
+	Pagination
+}
+
+func TestEncodeFilters(t *testing.T) {
+	active := false
+
+	filters, err := EncodeFilters(testFilters{
+		ID:           1,
+		IDs:          []int{1, 2, 3},
+		Types:        []string{"INVWAYBILL", "CASHINVOICE"},
+		Price:        10.5,
+		GetStock:     true,
+		Active:       &active,
+		ChangedSince: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Custom:       "val",
+		Ignored:      "ignored",
+		Pagination: Pagination{
+			RecordsOnPage: 100,
+			PageNo:        2,
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"id":            "1",
+		"ids":           "1,2,3",
+		"types":         "INVWAYBILL,CASHINVOICE",
+		"price":         "10.5",
+		"getStock":      "1",
+		"active":        "0",
+		"changedSince":  "1577836800",
+		"custom":        "custom:val",
+		"recordsOnPage": "100",
+		"pageNo":        "2",
+	}, filters)
+}
+
+func TestEncodeFiltersEmpty(t *testing.T) {
+	filters, err := EncodeFilters(&testFilters{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{}, filters)
+
+	_, err = EncodeFilters(map[string]string{})
+	assert.EqualError(t, err, "filters should be a struct, got map")
+}
+
+func TestEncodeBulkFilters(t *testing.T) {
+	filters, err := EncodeBulkFilters(testFilters{IDs: []int{4, 5}, Custom: "val"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"ids": "4,5", "custom": "custom:val"}, filters)
+}
+
+type testRow struct {
+	ProductID int     `json:"productID"`
+	Amount    float64 `json:"amount"`
+}
+
+type testInput struct {
+	ID         int            `json:"id"`
+	Rows       []testRow      `json:"rows,indexed"`
+	Attributes []ObjAttribute `json:"attributes,indexed"`
+}
+
+func TestEncodeIndexedRows(t *testing.T) {
+	filters, err := EncodeFilters(testInput{
+		ID: 1,
+		Rows: []testRow{
+			{ProductID: 10, Amount: 1},
+			{ProductID: 11, Amount: 0.5},
+		},
+		Attributes: []ObjAttribute{
+			{AttributeName: "color", AttributeType: "text", AttributeValue: "red"},
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"id":              "1",
+		"productID1":      "10",
+		"amount1":         "1",
+		"productID2":      "11",
+		"amount2":         "0.5",
+		"attributeName1":  "color",
+		"attributeType1":  "text",
+		"attributeValue1": "red",
+	}, filters)
+
+	_, err = EncodeFilters(struct {
+		Rows []int `json:"rows,indexed"`
+	}{Rows: []int{1}})
+	assert.EqualError(t, err, "cannot encode rows Rows: indexed row should be a struct, got int")
+}
@@ -0,0 +1,169 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+//FlexInt is an integer which is decoded from a JSON number or a string, empty strings and nulls give 0
+type FlexInt int
+
+//FlexFloat is a float which is decoded from a JSON number or a string, empty strings and nulls give 0
+type FlexFloat float64
+
+//FlexBool is a boolean which is decoded from a JSON boolean, a number or a string like "1", "0", "true" or "false",
+//empty strings and nulls give false, it's encoded as 1 or 0 like ERPLY does
+type FlexBool bool
+
+//unquoteFlexValue gives the raw value without quotes and whitespace, it's empty for nulls and empty strings
+func unquoteFlexValue(data []byte) (string, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return "", nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(str), nil
+	}
+
+	return string(data), nil
+}
+
+func (fi *FlexInt) UnmarshalJSON(data []byte) error {
+	raw, err := unquoteFlexValue(data)
+	if err != nil {
+		return err
+	}
+	if raw == "" {
+		*fi = 0
+		return nil
+	}
+
+	if intVal, err := strconv.ParseInt(raw, 10, strconv.IntSize); err == nil {
+		*fi = FlexInt(intVal)
+		return nil
+	}
+
+	floatVal, err := strconv.ParseFloat(raw, 64)
+	if err != nil || floatVal != math.Trunc(floatVal) {
+		return fmt.Errorf("cannot decode %s as an integer", string(data))
+	}
+	//the bounds are powers of two, so they are exact as floats unlike math.MaxInt64
+	minInt := -math.Ldexp(1, strconv.IntSize-1)
+	if floatVal < minInt || floatVal >= -minInt {
+		return fmt.Errorf("cannot decode %s as an integer: value out of range", string(data))
+	}
+	*fi = FlexInt(floatVal)
+
+	return nil
+}
+
+func (fi FlexInt) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(fi))), nil
+}
+
+//Int gives the value as int
+func (fi FlexInt) Int() int {
+	return int(fi)
+}
+
+func (fi FlexInt) String() string {
+	return strconv.Itoa(int(fi))
+}
+
+func (fi FlexInt) FilterValue() string {
+	if fi == 0 {
+		return ""
+	}
+	return fi.String()
+}
+
+func (ff *FlexFloat) UnmarshalJSON(data []byte) error {
+	raw, err := unquoteFlexValue(data)
+	if err != nil {
+		return err
+	}
+	if raw == "" {
+		*ff = 0
+		return nil
+	}
+
+	floatVal, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return fmt.Errorf("cannot decode %s as a number", string(data))
+	}
+	*ff = FlexFloat(floatVal)
+
+	return nil
+}
+
+func (ff FlexFloat) MarshalJSON() ([]byte, error) {
+	return []byte(ff.String()), nil
+}
+
+//Float64 gives the value as float64
+func (ff FlexFloat) Float64() float64 {
+	return float64(ff)
+}
+
+func (ff FlexFloat) String() string {
+	return strconv.FormatFloat(float64(ff), 'f', -1, 64)
+}
+
+func (ff FlexFloat) FilterValue() string {
+	if ff == 0 {
+		return ""
+	}
+	return ff.String()
+}
+
+func (fb *FlexBool) UnmarshalJSON(data []byte) error {
+	raw, err := unquoteFlexValue(data)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(raw) {
+	case "", "0", "false":
+		*fb = false
+		return nil
+	case "1", "true":
+		*fb = true
+		return nil
+	}
+
+	floatVal, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return fmt.Errorf("cannot decode %s as a boolean", string(data))
+	}
+	*fb = floatVal != 0
+
+	return nil
+}
+
+func (fb FlexBool) MarshalJSON() ([]byte, error) {
+	if fb {
+		return []byte("1"), nil
+	}
+	return []byte("0"), nil
+}
+
+//Bool gives the value as bool
+func (fb FlexBool) Bool() bool {
+	return bool(fb)
+}
+
+func (fb FlexBool) FilterValue() string {
+	if fb {
+		return "1"
+	}
+	return ""
+}
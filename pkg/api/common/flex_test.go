@@ -0,0 +1,87 @@
+package common
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFlexTypesDecoding(t *testing.T) {
+	testCases := []struct {
+		input         string
+		expectedInt   FlexInt
+		expectedFloat FlexFloat
+		expectedBool  FlexBool
+	}{
+		{input: `1`, expectedInt: 1, expectedFloat: 1, expectedBool: true},
+		{input: `"1"`, expectedInt: 1, expectedFloat: 1, expectedBool: true},
+		{input: `" 12 "`, expectedInt: 12, expectedFloat: 12, expectedBool: true},
+		{input: `"3.000000"`, expectedInt: 3, expectedFloat: 3, expectedBool: true},
+		{input: `0`, expectedInt: 0, expectedFloat: 0, expectedBool: false},
+		{input: `"0"`, expectedInt: 0, expectedFloat: 0, expectedBool: false},
+		{input: `""`, expectedInt: 0, expectedFloat: 0, expectedBool: false},
+		{input: `null`, expectedInt: 0, expectedFloat: 0, expectedBool: false},
+		{input: `-5`, expectedInt: -5, expectedFloat: -5, expectedBool: true},
+	}
+
+	for _, testCase := range testCases {
+		var fi FlexInt
+		assert.NoError(t, json.Unmarshal([]byte(testCase.input), &fi), testCase.input)
+		assert.Equal(t, testCase.expectedInt, fi, testCase.input)
+
+		var ff FlexFloat
+		assert.NoError(t, json.Unmarshal([]byte(testCase.input), &ff), testCase.input)
+		assert.Equal(t, testCase.expectedFloat, ff, testCase.input)
+
+		var fb FlexBool
+		assert.NoError(t, json.Unmarshal([]byte(testCase.input), &fb), testCase.input)
+		assert.Equal(t, testCase.expectedBool, fb, testCase.input)
+	}
+
+	var ff FlexFloat
+	assert.NoError(t, json.Unmarshal([]byte(`"10.55"`), &ff))
+	assert.Equal(t, 10.55, ff.Float64())
+
+	var fb FlexBool
+	assert.NoError(t, json.Unmarshal([]byte(`true`), &fb))
+	assert.True(t, fb.Bool())
+	assert.NoError(t, json.Unmarshal([]byte(`"false"`), &fb))
+	assert.False(t, fb.Bool())
+
+	var fi FlexInt
+	assert.EqualError(t, json.Unmarshal([]byte(`"1.5"`), &fi), `cannot decode "1.5" as an integer`)
+	assert.EqualError(t, json.Unmarshal([]byte(`"1e20"`), &fi), `cannot decode "1e20" as an integer: value out of range`)
+	assert.EqualError(t, json.Unmarshal([]byte(`9.3e18`), &fi), `cannot decode 9.3e18 as an integer: value out of range`)
+	assert.EqualError(t, json.Unmarshal([]byte(`-1e19`), &fi), `cannot decode -1e19 as an integer: value out of range`)
+	assert.NoError(t, json.Unmarshal([]byte(`"1e9"`), &fi))
+	assert.Equal(t, FlexInt(1e9), fi)
+	assert.EqualError(t, json.Unmarshal([]byte(`"abc"`), &ff), `cannot decode "abc" as a number`)
+	assert.EqualError(t, json.Unmarshal([]byte(`"maybe"`), &fb), `cannot decode "maybe" as a boolean`)
+}
+
+func TestFlexTypesEncoding(t *testing.T) {
+	encoded, err := json.Marshal(struct {
+		Int   FlexInt   `json:"int"`
+		Float FlexFloat `json:"float"`
+		Bool  FlexBool  `json:"bool"`
+	}{
+		Int:   5,
+		Float: 10.5,
+		Bool:  true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"int":5,"float":10.5,"bool":1}`, string(encoded))
+
+	filters, err := EncodeFilters(struct {
+		Int   FlexInt   `json:"int"`
+		Float FlexFloat `json:"float"`
+		Bool  FlexBool  `json:"bool"`
+		Zero  FlexInt   `json:"zero"`
+	}{
+		Int:   5,
+		Float: 10.5,
+		Bool:  true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"int": "5", "float": "10.5", "bool": "1"}, filters)
+}
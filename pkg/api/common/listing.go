@@ -10,6 +10,7 @@ const DefaultMaxFetchersCount = 1
 const DefaultMaxRequestsCountPerSecond = 0
 
 type ListingSettings struct {
+	//MaxRequestsCountPerSecond is ignored if the client of the data provider has a rate limiter
 	MaxRequestsCountPerSecond int
 	StreamBufferLength        int
 	MaxFetchersCount          int
@@ -52,21 +53,49 @@ type DataProvider interface {
 	Read(ctx context.Context, bulkFilters []map[string]interface{}, callback func(item interface{})) error
 }
 
+//ListingClient is embedded into the data providers of the SDK, it gives the Lister the tracer and the rate limiter
+//of their client
+type ListingClient struct {
+	client interface{}
+}
+
+//NewListingClient wraps the client of a data provider, usually its Manager
+func NewListingClient(client interface{}) ListingClient {
+	return ListingClient{client: client}
+}
+
+//GetTracer gives the tracer of the client or nil if it has none
+func (lc ListingClient) GetTracer() Tracer {
+	return TracerOf(lc.client)
+}
+
+//GetRateLimiter gives the rate limiter of the client or nil if it has none
+func (lc ListingClient) GetRateLimiter() RateLimiter {
+	return RateLimiterOf(lc.client)
+}
+
 type Lister struct {
 	listingSettings     ListingSettings
 	reqThrottler        Throttler
 	listingDataProvider DataProvider
+	tracer              Tracer
 }
 
 func NewLister(settings ListingSettings, dataProvider DataProvider, sl Sleeper) *Lister {
 	settings = setListingSettingsDefaults(settings)
 
-	thrl := NewSleepThrottler(settings.MaxRequestsCountPerSecond, sl)
+	limitPerSecond := settings.MaxRequestsCountPerSecond
+	//the client of the data provider waits for its limiter before each request, so the Lister doesn't throttle again
+	if RateLimiterOf(dataProvider) != nil {
+		limitPerSecond = 0
+	}
+	thrl := NewSleepThrottler(limitPerSecond, sl)
 
 	return &Lister{
 		listingSettings:     settings,
 		reqThrottler:        thrl,
 		listingDataProvider: dataProvider,
+		tracer:              TracerOf(dataProvider),
 	}
 }
 
@@ -110,13 +139,35 @@ func (p *Lister) GetGrouped(ctx context.Context, filters map[string]interface{},
 	return groupedItemsChan
 }
 
-func (p *Lister) Get(ctx context.Context, filters map[string]interface{}) ItemsStream {
+//withTracer adds the tracer of the data provider to the context unless the context already has one
+func (p *Lister) withTracer(ctx context.Context) context.Context {
+	if p.tracer == nil || TracerFromContext(ctx) != nil {
+		return ctx
+	}
+
+	return ContextWithTracer(ctx, p.tracer)
+}
+
+//throttle waits for the throttler, if it is a RateLimiter the waiting is interrupted by the context cancellation
+func (p *Lister) throttle(ctx context.Context) error {
+	if rateLimiter, ok := p.reqThrottler.(RateLimiter); ok {
+		return rateLimiter.Wait(ctx)
+	}
+
 	p.reqThrottler.Throttle()
+	return nil
+}
 
+func (p *Lister) Get(ctx context.Context, filters map[string]interface{}) ItemsStream {
+	ctx = p.withTracer(ctx)
 	filters["recordsOnPage"] = 1
 	filters["pageNo"] = 1
 
-	totalCount, err := p.listingDataProvider.Count(ctx, filters)
+	var totalCount int
+	err := p.throttle(ctx)
+	if err == nil {
+		totalCount, err = p.listingDataProvider.Count(ctx, filters)
+	}
 	if err != nil {
 		outputChan := make(ItemsStream, 1)
 		defer close(outputChan)
@@ -133,19 +184,25 @@ func (p *Lister) Get(ctx context.Context, filters map[string]interface{}) ItemsS
 
 	childChans := make([]ItemsStream, 0, p.listingSettings.MaxFetchersCount)
 	for i := 0; i < p.listingSettings.MaxFetchersCount; i++ {
-		childChan := p.fetchItemsChunk(ctx, cursorsChan, totalCount, filters)
+		childChan := p.fetchItemsChunk(ctx, i, cursorsChan, totalCount, filters)
 		childChans = append(childChans, childChan)
 	}
 
 	return p.mergeChannels(ctx, childChans...)
 }
 
-func (p *Lister) fetchItemsChunk(ctx context.Context, cursorChan chan []Cursor, totalCount int, filters map[string]interface{}) ItemsStream {
+func (p *Lister) fetchItemsChunk(
+	ctx context.Context,
+	fetcher int,
+	cursorChan chan []Cursor,
+	totalCount int,
+	filters map[string]interface{},
+) ItemsStream {
 	prodStream := make(chan Item, p.listingSettings.StreamBufferLength)
 	go func() {
 		defer close(prodStream)
 		for cursors := range cursorChan {
-			p.fetchItemsFromAPI(ctx, cursors, totalCount, prodStream, filters)
+			p.fetchItemsFromAPI(ctx, fetcher, cursors, totalCount, prodStream, filters)
 
 			select {
 			case <-ctx.Done():
@@ -214,6 +271,7 @@ func (p *Lister) getCursors(ctx context.Context, totalCount int) chan []Cursor {
 
 func (p *Lister) fetchItemsFromAPI(
 	ctx context.Context,
+	fetcher int,
 	cursors []Cursor,
 	totalCount int,
 	outputChan ItemsStream,
@@ -230,15 +288,26 @@ func (p *Lister) fetchItemsFromAPI(
 		bulkFilters = append(bulkFilters, bulkFilter)
 	}
 
-	p.reqThrottler.Throttle()
-
-	err := p.listingDataProvider.Read(ctx, bulkFilters, func(item interface{}) {
-		outputChan <- Item{
-			Err:        nil,
-			TotalCount: totalCount,
-			Payload:    item,
-		}
-	})
+	attributes := []Attribute{Attr(AttrFetcher, fetcher), Attr(AttrPagesCount, len(cursors))}
+	if len(cursors) > 0 {
+		attributes = append(attributes, Attr(AttrFirstPage, cursors[0].Offset))
+	}
+	ctx, span := StartSpan(ctx, SpanListerPage, attributes...)
+
+	itemsCount := 0
+	err := p.throttle(ctx)
+	if err == nil {
+		err = p.listingDataProvider.Read(ctx, bulkFilters, func(item interface{}) {
+			itemsCount++
+			outputChan <- Item{
+				Err:        nil,
+				TotalCount: totalCount,
+				Payload:    item,
+			}
+		})
+	}
+	span.SetAttributes(Attr(AttrItemsCount, itemsCount))
+	span.End(err)
 
 	if err != nil {
 		outputChan <- Item{
@@ -0,0 +1,285 @@
+package common
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+//BulkRequestMethod is the method name of bulk requests in the metrics
+const BulkRequestMethod = "bulk"
+
+//ThrottleSource tells what has held a request back
+type ThrottleSource string
+
+const (
+	ThrottleRateLimiter  ThrottleSource = "rateLimiter"
+	ThrottleQuotaTracker ThrottleSource = "quotaTracker"
+)
+
+//RequestSample describes one HTTP call to the API, repeated requests give a sample per attempt
+type RequestSample struct {
+	ClientCode string
+	//Method is the API request name or BulkRequestMethod
+	Method string
+	//HTTPStatus is 0 if no response was received
+	HTTPStatus int
+	//ErrorCode is the code of the response status, it's 0 for successful requests
+	ErrorCode ApiError
+	//Failed is true if no valid response was received, e.g. because of a network error
+	Failed   bool
+	Duration time.Duration
+	//SubRequests are the sub-requests of a bulk request with their result codes
+	SubRequests []SubRequestSample
+}
+
+//SubRequestSample describes one sub-request of a bulk request
+type SubRequestSample struct {
+	Method    string
+	ErrorCode ApiError
+}
+
+//Metrics collects the numbers of the requests made by the clients, the implementations must be safe for concurrent use
+type Metrics interface {
+	//ObserveRequest is called after each HTTP call to the API
+	ObserveRequest(sample RequestSample)
+	//ObserveSessionRefresh is called when a new session key is requested, err is the failure of the request if any
+	ObserveSessionRefresh(clientCode string, err error)
+	//ObserveThrottleWait is called when a request was held back by the rate limiter or the quota tracker
+	ObserveThrottleWait(clientCode string, source ThrottleSource, wait time.Duration)
+}
+
+//DefaultLatencyBuckets are the upper bounds of the latency histograms of InMemoryMetrics
+var DefaultLatencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+	30 * time.Second,
+}
+
+//Histogram counts durations in buckets, Counts[i] is the amount of durations not greater than Buckets[i]
+//which didn't fit into the previous buckets, the last element of Counts is for the longer durations
+type Histogram struct {
+	Buckets []time.Duration `json:"buckets"`
+	Counts  []int64         `json:"counts"`
+	Count   int64           `json:"count"`
+	Sum     time.Duration   `json:"sum"`
+}
+
+func newHistogram(buckets []time.Duration) Histogram {
+	return Histogram{
+		Buckets: buckets,
+		Counts:  make([]int64, len(buckets)+1),
+	}
+}
+
+func (h *Histogram) observe(dur time.Duration) {
+	i := sort.Search(len(h.Buckets), func(i int) bool {
+		return dur <= h.Buckets[i]
+	})
+	h.Counts[i]++
+	h.Count++
+	h.Sum += dur
+}
+
+func (h Histogram) copy() Histogram {
+	h.Counts = append([]int64{}, h.Counts...)
+	return h
+}
+
+//RequestStats are the numbers of one API method
+type RequestStats struct {
+	Count int64 `json:"count"`
+	//Failures is the amount of requests without a valid response
+	Failures    int64              `json:"failures"`
+	ByStatus    map[int]int64      `json:"byStatus"`
+	ByErrorCode map[ApiError]int64 `json:"byErrorCode"`
+	Latency     Histogram          `json:"latency"`
+}
+
+//SubRequestStats are the numbers of one API method sent as a bulk sub-request
+type SubRequestStats struct {
+	Count       int64              `json:"count"`
+	ByErrorCode map[ApiError]int64 `json:"byErrorCode"`
+}
+
+//WaitStats are the numbers of the waits caused by one throttle source
+type WaitStats struct {
+	Count int64         `json:"count"`
+	Total time.Duration `json:"total"`
+	Max   time.Duration `json:"max"`
+}
+
+//MetricsSnapshot is a copy of the numbers collected by InMemoryMetrics
+type MetricsSnapshot struct {
+	Requests               map[string]*RequestStats      `json:"requests"`
+	BulkSubRequests        map[string]*SubRequestStats   `json:"bulkSubRequests"`
+	SessionRefreshes       int64                         `json:"sessionRefreshes"`
+	SessionRefreshFailures int64                         `json:"sessionRefreshFailures"`
+	ThrottleWaits          map[ThrottleSource]*WaitStats `json:"throttleWaits"`
+}
+
+//InMemoryMetrics aggregates the metrics per API method in memory, share one instance between the clients
+//to get the totals of a process
+type InMemoryMetrics struct {
+	lock           sync.Mutex
+	latencyBuckets []time.Duration
+	snapshot       MetricsSnapshot
+}
+
+//NewInMemoryMetrics gives InMemoryMetrics with the latency histograms using the buckets,
+//DefaultLatencyBuckets are used if none are given
+func NewInMemoryMetrics(latencyBuckets ...time.Duration) *InMemoryMetrics {
+	if len(latencyBuckets) == 0 {
+		latencyBuckets = DefaultLatencyBuckets
+	}
+	latencyBuckets = append([]time.Duration{}, latencyBuckets...)
+	sort.Slice(latencyBuckets, func(i, j int) bool {
+		return latencyBuckets[i] < latencyBuckets[j]
+	})
+
+	m := &InMemoryMetrics{latencyBuckets: latencyBuckets}
+	m.reset()
+
+	return m
+}
+
+func (m *InMemoryMetrics) ObserveRequest(sample RequestSample) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	stats, ok := m.snapshot.Requests[sample.Method]
+	if !ok {
+		stats = &RequestStats{
+			ByStatus:    map[int]int64{},
+			ByErrorCode: map[ApiError]int64{},
+			Latency:     newHistogram(m.latencyBuckets),
+		}
+		m.snapshot.Requests[sample.Method] = stats
+	}
+
+	stats.Count++
+	if sample.Failed {
+		stats.Failures++
+	}
+	if sample.HTTPStatus != 0 {
+		stats.ByStatus[sample.HTTPStatus]++
+	}
+	if sample.ErrorCode != 0 {
+		stats.ByErrorCode[sample.ErrorCode]++
+	}
+	stats.Latency.observe(sample.Duration)
+
+	for _, subRequest := range sample.SubRequests {
+		subStats, ok := m.snapshot.BulkSubRequests[subRequest.Method]
+		if !ok {
+			subStats = &SubRequestStats{ByErrorCode: map[ApiError]int64{}}
+			m.snapshot.BulkSubRequests[subRequest.Method] = subStats
+		}
+
+		subStats.Count++
+		if subRequest.ErrorCode != 0 {
+			subStats.ByErrorCode[subRequest.ErrorCode]++
+		}
+	}
+}
+
+func (m *InMemoryMetrics) ObserveSessionRefresh(clientCode string, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.snapshot.SessionRefreshes++
+	if err != nil {
+		m.snapshot.SessionRefreshFailures++
+	}
+}
+
+func (m *InMemoryMetrics) ObserveThrottleWait(clientCode string, source ThrottleSource, wait time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	stats, ok := m.snapshot.ThrottleWaits[source]
+	if !ok {
+		stats = &WaitStats{}
+		m.snapshot.ThrottleWaits[source] = stats
+	}
+
+	stats.Count++
+	stats.Total += wait
+	if wait > stats.Max {
+		stats.Max = wait
+	}
+}
+
+//Snapshot gives a copy of the collected numbers
+func (m *InMemoryMetrics) Snapshot() MetricsSnapshot {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	snapshot := MetricsSnapshot{
+		Requests:               make(map[string]*RequestStats, len(m.snapshot.Requests)),
+		BulkSubRequests:        make(map[string]*SubRequestStats, len(m.snapshot.BulkSubRequests)),
+		SessionRefreshes:       m.snapshot.SessionRefreshes,
+		SessionRefreshFailures: m.snapshot.SessionRefreshFailures,
+		ThrottleWaits:          make(map[ThrottleSource]*WaitStats, len(m.snapshot.ThrottleWaits)),
+	}
+
+	for method, stats := range m.snapshot.Requests {
+		statsCopy := *stats
+		statsCopy.ByStatus = copyCounts(stats.ByStatus)
+		statsCopy.ByErrorCode = copyCodeCounts(stats.ByErrorCode)
+		statsCopy.Latency = stats.Latency.copy()
+		snapshot.Requests[method] = &statsCopy
+	}
+	for method, stats := range m.snapshot.BulkSubRequests {
+		snapshot.BulkSubRequests[method] = &SubRequestStats{
+			Count:       stats.Count,
+			ByErrorCode: copyCodeCounts(stats.ByErrorCode),
+		}
+	}
+	for source, stats := range m.snapshot.ThrottleWaits {
+		statsCopy := *stats
+		snapshot.ThrottleWaits[source] = &statsCopy
+	}
+
+	return snapshot
+}
+
+//Reset clears the collected numbers
+func (m *InMemoryMetrics) Reset() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.reset()
+}
+
+func (m *InMemoryMetrics) reset() {
+	m.snapshot = MetricsSnapshot{
+		Requests:        map[string]*RequestStats{},
+		BulkSubRequests: map[string]*SubRequestStats{},
+		ThrottleWaits:   map[ThrottleSource]*WaitStats{},
+	}
+}
+
+func copyCounts(counts map[int]int64) map[int]int64 {
+	countsCopy := make(map[int]int64, len(counts))
+	for key, count := range counts {
+		countsCopy[key] = count
+	}
+
+	return countsCopy
+}
+
+func copyCodeCounts(counts map[ApiError]int64) map[ApiError]int64 {
+	countsCopy := make(map[ApiError]int64, len(counts))
+	for key, count := range counts {
+		countsCopy[key] = count
+	}
+
+	return countsCopy
+}
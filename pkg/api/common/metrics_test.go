@@ -0,0 +1,61 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"expvar"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestInMemoryMetrics(t *testing.T) {
+	metrics := NewInMemoryMetrics(time.Second, 100*time.Millisecond)
+
+	metrics.ObserveRequest(RequestSample{Method: "getProducts", HTTPStatus: 200, Duration: 50 * time.Millisecond})
+	metrics.ObserveRequest(RequestSample{Method: "getProducts", HTTPStatus: 200, ErrorCode: HourlyRequestQuota, Duration: 500 * time.Millisecond})
+	metrics.ObserveRequest(RequestSample{Method: "getProducts", Failed: true, Duration: 2 * time.Second})
+	metrics.ObserveSessionRefresh("123", nil)
+	metrics.ObserveSessionRefresh("123", errors.New("some error"))
+	metrics.ObserveThrottleWait("123", ThrottleQuotaTracker, time.Second)
+	metrics.ObserveThrottleWait("123", ThrottleQuotaTracker, 3*time.Second)
+
+	snapshot := metrics.Snapshot()
+	assert.Equal(t, &RequestStats{
+		Count:       3,
+		Failures:    1,
+		ByStatus:    map[int]int64{200: 2},
+		ByErrorCode: map[ApiError]int64{HourlyRequestQuota: 1},
+		Latency: Histogram{
+			Buckets: []time.Duration{100 * time.Millisecond, time.Second},
+			Counts:  []int64{1, 1, 1},
+			Count:   3,
+			Sum:     2550 * time.Millisecond,
+		},
+	}, snapshot.Requests["getProducts"])
+	assert.Equal(t, int64(2), snapshot.SessionRefreshes)
+	assert.Equal(t, int64(1), snapshot.SessionRefreshFailures)
+	assert.Equal(t, &WaitStats{Count: 2, Total: 4 * time.Second, Max: 3 * time.Second}, snapshot.ThrottleWaits[ThrottleQuotaTracker])
+
+	metrics.ObserveRequest(RequestSample{Method: "getProducts", HTTPStatus: 200})
+	assert.Equal(t, int64(3), snapshot.Requests["getProducts"].Count)
+	assert.Equal(t, int64(2), snapshot.Requests["getProducts"].ByStatus[200])
+
+	metrics.Reset()
+	assert.Empty(t, metrics.Snapshot().Requests)
+}
+
+func TestExpvarMetrics(t *testing.T) {
+	metrics := NewExpvarMetrics("erplyTestMetrics")
+	metrics.ObserveRequest(RequestSample{Method: "getProducts", HTTPStatus: 200, ErrorCode: RequiredParamMissing})
+
+	published := expvar.Get("erplyTestMetrics")
+	if !assert.NotNil(t, published) {
+		return
+	}
+
+	var snapshot MetricsSnapshot
+	assert.NoError(t, json.Unmarshal([]byte(published.String()), &snapshot))
+	assert.Equal(t, int64(1), snapshot.Requests["getProducts"].Count)
+	assert.Equal(t, int64(1), snapshot.Requests["getProducts"].ByErrorCode[RequiredParamMissing])
+}
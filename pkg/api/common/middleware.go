@@ -0,0 +1,81 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+//BulkInput describes one sub-request of a bulk API call
+type BulkInput struct {
+	MethodName string
+	Filters    map[string]interface{}
+}
+
+//Request describes an outgoing API call as it's seen by middlewares, a middleware can change Filters, BulkInputs
+//or Header to rewrite the call before it's sent
+type Request struct {
+	//Method is the ERPLY request name, it's empty for bulk calls
+	Method string
+	//Filters contains the request parameters, for bulk calls these are the parameters shared by all sub-requests
+	Filters map[string]string
+	//BulkInputs contains the sub-requests of a bulk call, it's nil for single calls
+	BulkInputs []BulkInput
+	//Header is added to the outgoing HTTP request
+	Header http.Header
+	//SessionKey is the session key which was sent with the request, it's set when the HTTP request is built
+	SessionKey string
+}
+
+//IsBulk tells if the request is a bulk call
+func (r *Request) IsBulk() bool {
+	return r.BulkInputs != nil
+}
+
+//Response holds the raw result of an API call together with the decoded status
+type Response struct {
+	HTTPResponse *http.Response
+	//Body is the full response body, it will be given to the caller as HTTPResponse.Body
+	Body []byte
+	//Status is nil if the body doesn't contain a status e.g. for non JSON responses
+	Status *Status
+	//BulkStatuses contains the statuses of the bulk sub-requests in the order of their inputs
+	BulkStatuses []StatusBulk
+}
+
+//RequestHandler sends the request to the API and returns the response
+type RequestHandler func(ctx context.Context, req *Request) (*Response, error)
+
+//Middleware wraps a RequestHandler to be able to inspect or change requests and responses. A middleware may answer
+//the request without calling the next handler, the response needs only the Body then
+type Middleware func(next RequestHandler) RequestHandler
+
+//ChainMiddlewares wraps the handler with middlewares, the first middleware is the outermost one
+func ChainMiddlewares(handler RequestHandler, middlewares ...Middleware) RequestHandler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+
+	return handler
+}
+
+//DecodeStatuses extracts the response status and the bulk sub-request statuses from a response body,
+//the status is nil if the body cannot be decoded
+func DecodeStatuses(body []byte) (status *Status, bulkStatuses []StatusBulk) {
+	var envelope struct {
+		Status   *Status `json:"status"`
+		Requests []struct {
+			Status StatusBulk `json:"status"`
+		} `json:"requests"`
+	}
+
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, nil
+	}
+
+	for _, bulkItem := range envelope.Requests {
+		bulkStatuses = append(bulkStatuses, bulkItem.Status)
+	}
+
+	return envelope.Status, bulkStatuses
+}
@@ -0,0 +1,57 @@
+package common
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestChainMiddlewares(t *testing.T) {
+	calls := []string{}
+	newMiddleware := func(name string) Middleware {
+		return func(next RequestHandler) RequestHandler {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				calls = append(calls, name+" before")
+				resp, err := next(ctx, req)
+				calls = append(calls, name+" after")
+				return resp, err
+			}
+		}
+	}
+
+	handler := ChainMiddlewares(
+		func(ctx context.Context, req *Request) (*Response, error) {
+			calls = append(calls, "handler "+req.Method)
+			return &Response{}, nil
+		},
+		newMiddleware("first"),
+		newMiddleware("second"),
+	)
+
+	_, err := handler(context.Background(), &Request{Method: "getProducts"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"first before",
+		"second before",
+		"handler getProducts",
+		"second after",
+		"first after",
+	}, calls)
+}
+
+func TestDecodeStatuses(t *testing.T) {
+	status, bulkStatuses := DecodeStatuses([]byte(`{"status":{"request":"getProducts","responseStatus":"error","errorCode":1054}}`))
+	assert.Equal(t, APISessionExpired, status.ErrorCode)
+	assert.Equal(t, "getProducts", status.Request)
+	assert.Len(t, bulkStatuses, 0)
+
+	status, bulkStatuses = DecodeStatuses([]byte(`{"status":{"responseStatus":"ok"},"requests":[{"status":{"requestID":"1","responseStatus":"ok"}}]}`))
+	assert.Equal(t, "ok", status.ResponseStatus)
+	assert.Len(t, bulkStatuses, 1)
+	assert.Equal(t, "1", bulkStatuses[0].RequestID)
+
+	status, bulkStatuses = DecodeStatuses([]byte(`some junk value`))
+	assert.Nil(t, status)
+	assert.Nil(t, bulkStatuses)
+}
@@ -0,0 +1,316 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+//MoneyScale is the amount of decimal places which Money keeps exactly
+const MoneyScale = 6
+
+var moneyFactor = big.NewInt(1000000)
+
+//maxMoneyUnits limits the values to a symmetric range, so negating a valid value never overflows
+var maxMoneyUnits = big.NewInt(math.MaxInt64)
+
+//ErrMoneyOutOfRange is given when a value or a result of an operation doesn't fit into Money,
+//the limit is about ±9.2e12
+var ErrMoneyOutOfRange = errors.New("money value is out of range")
+
+//ErrMoneyDivisionByZero is given by Div if the divisor is zero
+var ErrMoneyDivisionByZero = errors.New("money division by zero")
+
+//RoundingMode tells how a value is rounded when it doesn't fit into the required decimal places
+type RoundingMode int
+
+const (
+	//RoundHalfUp rounds to the nearest value, halves are rounded away from zero
+	RoundHalfUp RoundingMode = iota
+	//RoundHalfEven rounds to the nearest value, halves are rounded to the even digit (banker's rounding)
+	RoundHalfEven
+	//RoundDown rounds towards zero
+	RoundDown
+	//RoundUp rounds away from zero
+	RoundUp
+	//RoundFloor rounds towards negative infinity
+	RoundFloor
+	//RoundCeiling rounds towards positive infinity
+	RoundCeiling
+)
+
+//Money is an exact decimal value with MoneyScale decimal places, it's decoded from JSON numbers and strings,
+//empty strings and nulls give zero. The zero value is 0 and values can be compared with ==
+type Money struct {
+	units int64
+}
+
+//NewMoneyFromInt gives Money with the integer value
+func NewMoneyFromInt(value int64) (Money, error) {
+	return moneyFromBig(new(big.Int).Mul(big.NewInt(value), moneyFactor))
+}
+
+//MustNewMoneyFromInt is NewMoneyFromInt which panics on values out of range, it's handy for constants
+func MustNewMoneyFromInt(value int64) Money {
+	m, err := NewMoneyFromInt(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+//NewMoneyFromFloat gives Money with the shortest decimal representation of the float, e.g. 0.1 gives exactly 0.1,
+//NaN, infinities and values out of range give an error
+func NewMoneyFromFloat(value float64) (Money, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Money{}, fmt.Errorf("cannot convert %v to money", value)
+	}
+
+	return ParseMoney(strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+//MustNewMoneyFromFloat is NewMoneyFromFloat which panics on invalid values, it's handy for constants
+func MustNewMoneyFromFloat(value float64) Money {
+	m, err := NewMoneyFromFloat(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+//ParseMoney parses a decimal string like "10.50" or "-3", values with more decimal places than MoneyScale
+//are rounded half up
+func ParseMoney(value string) (Money, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Money{}, nil
+	}
+
+	rat, ok := new(big.Rat).SetString(value)
+	if !ok || strings.Contains(value, "/") {
+		return Money{}, fmt.Errorf("cannot parse %q as money", value)
+	}
+
+	num := new(big.Int).Mul(rat.Num(), moneyFactor)
+	m, err := moneyFromBig(roundQuotient(num, rat.Denom(), RoundHalfUp))
+	if err != nil {
+		return Money{}, fmt.Errorf("money value %q is out of range", value)
+	}
+
+	return m, nil
+}
+
+//MustParseMoney is ParseMoney which panics on invalid values, it's handy for constants
+func MustParseMoney(value string) Money {
+	m, err := ParseMoney(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+//roundQuotient divides num by den and rounds the result with the mode
+func roundQuotient(num, den *big.Int, mode RoundingMode) *big.Int {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() != 0 {
+		sign := int64(num.Sign() * den.Sign())
+		twiceRem := new(big.Int).Abs(rem)
+		twiceRem.Lsh(twiceRem, 1)
+		halfCmp := twiceRem.Cmp(new(big.Int).Abs(den))
+
+		increment := false
+		switch mode {
+		case RoundHalfUp:
+			increment = halfCmp >= 0
+		case RoundHalfEven:
+			increment = halfCmp > 0 || (halfCmp == 0 && quo.Bit(0) == 1)
+		case RoundUp:
+			increment = true
+		case RoundFloor:
+			increment = sign < 0
+		case RoundCeiling:
+			increment = sign > 0
+		}
+
+		if increment {
+			quo.Add(quo, big.NewInt(sign))
+		}
+	}
+
+	return quo
+}
+
+//moneyFromBig gives Money with the units or ErrMoneyOutOfRange if they don't fit
+func moneyFromBig(units *big.Int) (Money, error) {
+	if new(big.Int).Abs(units).Cmp(maxMoneyUnits) > 0 {
+		return Money{}, ErrMoneyOutOfRange
+	}
+
+	return Money{units: units.Int64()}, nil
+}
+
+//Add gives the sum of the values or ErrMoneyOutOfRange if it overflows
+func (m Money) Add(other Money) (Money, error) {
+	return moneyFromBig(new(big.Int).Add(big.NewInt(m.units), big.NewInt(other.units)))
+}
+
+//Sub gives the difference of the values or ErrMoneyOutOfRange if it overflows
+func (m Money) Sub(other Money) (Money, error) {
+	return moneyFromBig(new(big.Int).Sub(big.NewInt(m.units), big.NewInt(other.units)))
+}
+
+func (m Money) Neg() Money {
+	return Money{units: -m.units}
+}
+
+func (m Money) Abs() Money {
+	if m.units < 0 {
+		return m.Neg()
+	}
+
+	return m
+}
+
+//Mul multiplies the values, e.g. a price by an amount, the result is rounded to MoneyScale with the mode
+func (m Money) Mul(other Money, mode RoundingMode) (Money, error) {
+	num := new(big.Int).Mul(big.NewInt(m.units), big.NewInt(other.units))
+	return moneyFromBig(roundQuotient(num, moneyFactor, mode))
+}
+
+//MulInt multiplies the value by an integer
+func (m Money) MulInt(multiplier int64) (Money, error) {
+	return moneyFromBig(new(big.Int).Mul(big.NewInt(m.units), big.NewInt(multiplier)))
+}
+
+//Div divides the values, the result is rounded to MoneyScale with the mode
+func (m Money) Div(divisor Money, mode RoundingMode) (Money, error) {
+	if divisor.units == 0 {
+		return Money{}, ErrMoneyDivisionByZero
+	}
+
+	num := new(big.Int).Mul(big.NewInt(m.units), moneyFactor)
+	return moneyFromBig(roundQuotient(num, big.NewInt(divisor.units), mode))
+}
+
+//Round rounds the value to the decimal places with the mode, e.g. Round(2, RoundHalfEven) for cents,
+//rounding up the values close to the limit gives ErrMoneyOutOfRange
+func (m Money) Round(places int, mode RoundingMode) (Money, error) {
+	return moneyFromBig(m.roundedUnits(places, mode))
+}
+
+//roundedUnits gives the units of the value rounded to the decimal places
+func (m Money) roundedUnits(places int, mode RoundingMode) *big.Int {
+	if places >= MoneyScale {
+		return big.NewInt(m.units)
+	}
+	if places < 0 {
+		places = 0
+	}
+
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(MoneyScale-places)), nil)
+	rounded := roundQuotient(big.NewInt(m.units), factor, mode)
+
+	return rounded.Mul(rounded, factor)
+}
+
+//Cmp gives -1 if m < other, 0 if they are equal and 1 if m > other
+func (m Money) Cmp(other Money) int {
+	switch {
+	case m.units < other.units:
+		return -1
+	case m.units > other.units:
+		return 1
+	}
+
+	return 0
+}
+
+func (m Money) Sign() int {
+	return m.Cmp(Money{})
+}
+
+func (m Money) IsZero() bool {
+	return m.units == 0
+}
+
+//String gives the exact decimal value without trailing zeros, e.g. "10.5"
+func (m Money) String() string {
+	str := m.StringFixed(MoneyScale)
+	if strings.Contains(str, ".") {
+		str = strings.TrimRight(strings.TrimRight(str, "0"), ".")
+	}
+
+	return str
+}
+
+//StringFixed gives the value rounded half up to the decimal places with trailing zeros, e.g. "10.50"
+func (m Money) StringFixed(places int) string {
+	if places > MoneyScale {
+		places = MoneyScale
+	}
+	if places < 0 {
+		places = 0
+	}
+
+	units := m.roundedUnits(places, RoundHalfUp)
+
+	sign := ""
+	if units.Sign() < 0 {
+		sign = "-"
+	}
+	abs := new(big.Int).Abs(units)
+	intPart, fracPart := new(big.Int).QuoRem(abs, moneyFactor, new(big.Int))
+
+	if places == 0 {
+		return sign + intPart.String()
+	}
+
+	frac := fmt.Sprintf("%0*d", MoneyScale, fracPart.Int64())[:places]
+
+	return sign + intPart.String() + "." + frac
+}
+
+//Float64 gives the nearest float value, it's kept for compatibility with the former float fields
+func (m Money) Float64() float64 {
+	f, _ := strconv.ParseFloat(m.String(), 64)
+	return f
+}
+
+//Float32 gives the nearest float32 value, it's kept for compatibility with the former float fields
+func (m Money) Float32() float32 {
+	f, _ := strconv.ParseFloat(m.String(), 32)
+	return float32(f)
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	raw, err := unquoteFlexValue(data)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := ParseMoney(raw)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+
+	return nil
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m Money) FilterValue() string {
+	if m.IsZero() {
+		return ""
+	}
+
+	return m.String()
+}
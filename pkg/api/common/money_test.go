@@ -0,0 +1,169 @@
+package common
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "10.50", expected: "10.5"},
+		{input: "-3", expected: "-3"},
+		{input: "0.000000", expected: "0"},
+		{input: "", expected: "0"},
+		{input: "1e-2", expected: "0.01"},
+		{input: "0.0000005", expected: "0.000001"},
+		{input: "-0.0000005", expected: "-0.000001"},
+		{input: "123456789.123456", expected: "123456789.123456"},
+	}
+
+	for _, testCase := range testCases {
+		m, err := ParseMoney(testCase.input)
+		assert.NoError(t, err, testCase.input)
+		assert.Equal(t, testCase.expected, m.String(), testCase.input)
+	}
+
+	_, err := ParseMoney("abc")
+	assert.EqualError(t, err, `cannot parse "abc" as money`)
+	_, err = ParseMoney("1/3")
+	assert.EqualError(t, err, `cannot parse "1/3" as money`)
+	_, err = ParseMoney("1e30")
+	assert.EqualError(t, err, `money value "1e30" is out of range`)
+}
+
+//moneyChecker gives a function which fails the test if the money operation gave an error
+func moneyChecker(t *testing.T) func(m Money, err error) Money {
+	return func(m Money, err error) Money {
+		assert.NoError(t, err)
+		return m
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	mustMoney := moneyChecker(t)
+	price := MustParseMoney("0.1")
+	sum := Money{}
+	for i := 0; i < 10; i++ {
+		sum = mustMoney(sum.Add(price))
+	}
+	assert.Equal(t, MustNewMoneyFromInt(1), sum)
+
+	assert.Equal(t, "0.3", mustMoney(MustNewMoneyFromFloat(0.1).Add(MustNewMoneyFromFloat(0.2))).String())
+	assert.Equal(t, "-0.1", mustMoney(MustParseMoney("0.2").Sub(MustParseMoney("0.3"))).String())
+	assert.Equal(t, "0.1", MustParseMoney("-0.1").Abs().String())
+	assert.Equal(t, "29.97", mustMoney(MustParseMoney("9.99").MulInt(3)).String())
+	assert.Equal(t, "24.975", mustMoney(MustParseMoney("9.99").Mul(MustParseMoney("2.5"), RoundHalfUp)).String())
+	assert.Equal(t, "3.333333", mustMoney(MustParseMoney("10").Div(MustParseMoney("3"), RoundHalfUp)).String())
+	assert.Equal(t, "6.666667", mustMoney(MustParseMoney("20").Div(MustParseMoney("3"), RoundHalfUp)).String())
+	assert.Equal(t, "6.666666", mustMoney(MustParseMoney("20").Div(MustParseMoney("3"), RoundDown)).String())
+
+	assert.Equal(t, -1, MustParseMoney("1").Cmp(MustParseMoney("1.000001")))
+	assert.Equal(t, 0, MustParseMoney("1").Cmp(MustParseMoney("1.000")))
+	assert.Equal(t, 1, MustParseMoney("-1").Neg().Sign())
+	assert.True(t, Money{}.IsZero())
+
+	_, err := MustParseMoney("1").Div(Money{}, RoundHalfUp)
+	assert.Equal(t, ErrMoneyDivisionByZero, err)
+}
+
+func TestMoneyOutOfRange(t *testing.T) {
+	maxMoney := MustParseMoney("9223372036854.775807")
+	minMoney := maxMoney.Neg()
+	one := MustNewMoneyFromInt(1)
+
+	_, err := maxMoney.Add(one)
+	assert.Equal(t, ErrMoneyOutOfRange, err)
+	_, err = minMoney.Sub(one)
+	assert.Equal(t, ErrMoneyOutOfRange, err)
+	_, err = maxMoney.MulInt(2)
+	assert.Equal(t, ErrMoneyOutOfRange, err)
+	_, err = maxMoney.Mul(MustParseMoney("1.5"), RoundHalfUp)
+	assert.Equal(t, ErrMoneyOutOfRange, err)
+	_, err = maxMoney.Div(MustParseMoney("0.5"), RoundHalfUp)
+	assert.Equal(t, ErrMoneyOutOfRange, err)
+	_, err = maxMoney.Round(2, RoundUp)
+	assert.Equal(t, ErrMoneyOutOfRange, err)
+	assert.Equal(t, "9223372036854.78", maxMoney.StringFixed(2))
+	assert.Equal(t, "9223372036854.775807", minMoney.Abs().String())
+
+	_, err = NewMoneyFromInt(10000000000000)
+	assert.Equal(t, ErrMoneyOutOfRange, err)
+	_, err = ParseMoney("-9223372036854.775808")
+	assert.Error(t, err)
+
+	for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), 1e13} {
+		_, err := NewMoneyFromFloat(value)
+		assert.Error(t, err, value)
+	}
+	assert.Panics(t, func() {
+		MustNewMoneyFromFloat(math.NaN())
+	})
+}
+
+func TestMoneyRounding(t *testing.T) {
+	mustMoney := moneyChecker(t)
+	testCases := []struct {
+		input    string
+		mode     RoundingMode
+		expected string
+	}{
+		{input: "2.345", mode: RoundHalfUp, expected: "2.35"},
+		{input: "2.345", mode: RoundHalfEven, expected: "2.34"},
+		{input: "2.355", mode: RoundHalfEven, expected: "2.36"},
+		{input: "2.3451", mode: RoundHalfEven, expected: "2.35"},
+		{input: "2.349", mode: RoundDown, expected: "2.34"},
+		{input: "2.341", mode: RoundUp, expected: "2.35"},
+		{input: "-2.345", mode: RoundHalfUp, expected: "-2.35"},
+		{input: "-2.341", mode: RoundDown, expected: "-2.34"},
+		{input: "-2.341", mode: RoundUp, expected: "-2.35"},
+		{input: "-2.341", mode: RoundFloor, expected: "-2.35"},
+		{input: "-2.349", mode: RoundCeiling, expected: "-2.34"},
+		{input: "2.341", mode: RoundCeiling, expected: "2.35"},
+		{input: "2.349", mode: RoundFloor, expected: "2.34"},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(
+			t,
+			testCase.expected,
+			mustMoney(MustParseMoney(testCase.input).Round(2, testCase.mode)).String(),
+			testCase.input,
+		)
+	}
+
+	assert.Equal(t, "3", mustMoney(MustParseMoney("2.5").Round(0, RoundHalfUp)).String())
+	assert.Equal(t, "2", mustMoney(MustParseMoney("2.5").Round(0, RoundHalfEven)).String())
+	assert.Equal(t, "10.50", MustParseMoney("10.5").StringFixed(2))
+	assert.Equal(t, "-0.01", MustParseMoney("-0.005").StringFixed(2))
+	assert.Equal(t, "11", MustParseMoney("10.5").StringFixed(0))
+}
+
+func TestMoneyJSON(t *testing.T) {
+	var decoded struct {
+		Price    Money `json:"price"`
+		Total    Money `json:"total"`
+		Empty    Money `json:"empty"`
+		Null     Money `json:"null"`
+		Negative Money `json:"negative"`
+	}
+	err := json.Unmarshal([]byte(`{"price":"10.500000","total":20.1,"empty":"","null":null,"negative":-1.25}`), &decoded)
+	assert.NoError(t, err)
+
+	assert.Equal(t, MustParseMoney("10.5"), decoded.Price)
+	assert.Equal(t, MustParseMoney("20.1"), decoded.Total)
+	assert.True(t, decoded.Empty.IsZero())
+	assert.True(t, decoded.Null.IsZero())
+	assert.Equal(t, -1.25, decoded.Negative.Float64())
+	assert.Equal(t, float32(20.1), decoded.Total.Float32())
+
+	encoded, err := json.Marshal(decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"price":10.5,"total":20.1,"empty":0,"null":0,"negative":-1.25}`, string(encoded))
+
+	assert.EqualError(t, json.Unmarshal([]byte(`{"price":"ten"}`), &decoded), `cannot parse "ten" as money`)
+}
@@ -0,0 +1,186 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+//Priority marks how important a request is for the quota tracking
+type Priority int
+
+const (
+	PriorityNormal Priority = iota
+	PriorityLow
+)
+
+type priorityCtxKey struct{}
+
+//WithPriority gives a context which marks all requests made with it with the priority
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityCtxKey{}, priority)
+}
+
+//PriorityFromContext gives the priority of the requests made with the context, it's PriorityNormal by default
+func PriorityFromContext(ctx context.Context) Priority {
+	priority, ok := ctx.Value(priorityCtxKey{}).(Priority)
+	if !ok {
+		return PriorityNormal
+	}
+
+	return priority
+}
+
+//QuotaSettings configures QuotaTracker
+type QuotaSettings struct {
+	//HourlyLimit is the amount of requests per hour allowed for the account, 0 means that only
+	//the HourlyRequestQuota errors are tracked
+	HourlyLimit int
+	//LowPriorityReserve is the amount of requests which is kept for normal priority requests,
+	//low priority requests are not sent once the remaining budget reaches this value
+	LowPriorityReserve int
+	//RejectInsteadOfWaiting makes the tracker fail the requests which are not allowed instead of
+	//blocking them until the next hour
+	RejectInsteadOfWaiting bool
+	//CountBulkAsOne counts a bulk request as a single request, by default each sub-request is counted
+	CountBulkAsOne bool
+}
+
+type hourlyUsage struct {
+	hour           time.Time
+	count          int
+	exhaustedUntil time.Time
+}
+
+//QuotaTracker counts the requests per client code in the current hour and holds back requests
+//when the hourly quota is exhausted, it's safe for concurrent use
+type QuotaTracker struct {
+	settings QuotaSettings
+	lock     sync.Mutex
+	usage    map[string]*hourlyUsage
+	clock    func() time.Time
+}
+
+//NewQuotaTracker creates QuotaTracker, share the instance between clients of the same account
+func NewQuotaTracker(settings QuotaSettings) *QuotaTracker {
+	return &QuotaTracker{
+		settings: settings,
+		usage:    map[string]*hourlyUsage{},
+		clock:    time.Now,
+	}
+}
+
+//Used gives the amount of requests made in the current hour for the client code
+func (qt *QuotaTracker) Used(clientCode string) int {
+	qt.lock.Lock()
+	defer qt.lock.Unlock()
+
+	return qt.getUsage(clientCode).count
+}
+
+//Remaining gives the amount of requests which can be made in the current hour for the client code,
+//it gives -1 if the hourly limit is not configured
+func (qt *QuotaTracker) Remaining(clientCode string) int {
+	qt.lock.Lock()
+	defer qt.lock.Unlock()
+
+	usage := qt.getUsage(clientCode)
+	if usage.exhaustedUntil.After(qt.clock()) {
+		return 0
+	}
+	if qt.settings.HourlyLimit <= 0 {
+		return -1
+	}
+
+	remaining := qt.settings.HourlyLimit - usage.count
+	if remaining < 0 {
+		return 0
+	}
+
+	return remaining
+}
+
+//RequestCost gives the amount of quota which the request consumes
+func (qt *QuotaTracker) RequestCost(req *Request) int {
+	if req.IsBulk() && !qt.settings.CountBulkAsOne {
+		return len(req.BulkInputs)
+	}
+
+	return 1
+}
+
+//Acquire counts the request with the given cost, if the request is not allowed it blocks until the next hour
+//or fails depending on the settings
+func (qt *QuotaTracker) Acquire(ctx context.Context, clientCode string, cost int) error {
+	for {
+		waitUntil, err := qt.tryAcquire(ctx, clientCode, cost)
+		if err != nil || waitUntil.IsZero() {
+			return err
+		}
+
+		timer := time.NewTimer(waitUntil.Sub(qt.clock()))
+		select {
+		case <-timer.C:
+			continue
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+func (qt *QuotaTracker) tryAcquire(ctx context.Context, clientCode string, cost int) (waitUntil time.Time, err error) {
+	qt.lock.Lock()
+	defer qt.lock.Unlock()
+
+	now := qt.clock()
+	usage := qt.getUsage(clientCode)
+
+	switch {
+	case usage.exhaustedUntil.After(now):
+		waitUntil = usage.exhaustedUntil
+	case qt.settings.HourlyLimit > 0 && PriorityFromContext(ctx) == PriorityLow &&
+		qt.settings.HourlyLimit-usage.count-cost < qt.settings.LowPriorityReserve:
+		waitUntil = usage.hour.Add(time.Hour)
+	default:
+		usage.count += cost
+		return time.Time{}, nil
+	}
+
+	if qt.settings.RejectInsteadOfWaiting {
+		return time.Time{}, NewErplyError(
+			"Error",
+			fmt.Sprintf("hourly request quota for %s is exhausted till %v", clientCode, waitUntil),
+			HourlyRequestQuota,
+		)
+	}
+
+	return waitUntil, nil
+}
+
+//MarkExhausted holds back all requests for the client code until the next hour, it's called when the API
+//responds with the HourlyRequestQuota error
+func (qt *QuotaTracker) MarkExhausted(clientCode string) {
+	qt.lock.Lock()
+	defer qt.lock.Unlock()
+
+	usage := qt.getUsage(clientCode)
+	usage.exhaustedUntil = usage.hour.Add(time.Hour)
+}
+
+func (qt *QuotaTracker) getUsage(clientCode string) *hourlyUsage {
+	currentHour := qt.clock().Truncate(time.Hour)
+
+	usage, ok := qt.usage[clientCode]
+	if !ok {
+		usage = &hourlyUsage{hour: currentHour}
+		qt.usage[clientCode] = usage
+	}
+	if usage.hour.Before(currentHour) {
+		usage.hour = currentHour
+		usage.count = 0
+	}
+
+	return usage
+}
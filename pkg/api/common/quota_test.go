@@ -0,0 +1,94 @@
+package common
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newQuotaTrackerWithClock(settings QuotaSettings) (*QuotaTracker, *clockMock) {
+	clock := &clockMock{now: time.Date(2020, 1, 1, 10, 30, 0, 0, time.UTC)}
+	qt := NewQuotaTracker(settings)
+	qt.clock = clock.Now
+
+	return qt, clock
+}
+
+func TestQuotaTrackerCounting(t *testing.T) {
+	qt, clock := newQuotaTrackerWithClock(QuotaSettings{HourlyLimit: 100})
+	ctx := context.Background()
+
+	assert.NoError(t, qt.Acquire(ctx, "123", 1))
+	assert.NoError(t, qt.Acquire(ctx, "123", 10))
+	assert.NoError(t, qt.Acquire(ctx, "456", 5))
+
+	assert.Equal(t, 11, qt.Used("123"))
+	assert.Equal(t, 89, qt.Remaining("123"))
+	assert.Equal(t, 95, qt.Remaining("456"))
+
+	clock.now = clock.now.Add(30 * time.Minute)
+	assert.Equal(t, 0, qt.Used("123"))
+	assert.Equal(t, 100, qt.Remaining("123"))
+
+	qt, _ = newQuotaTrackerWithClock(QuotaSettings{})
+	assert.Equal(t, -1, qt.Remaining("123"))
+}
+
+func TestQuotaTrackerRequestCost(t *testing.T) {
+	bulkReq := &Request{BulkInputs: []BulkInput{{MethodName: "getProducts"}, {MethodName: "getProducts"}}}
+
+	qt := NewQuotaTracker(QuotaSettings{})
+	assert.Equal(t, 1, qt.RequestCost(&Request{Method: "getProducts"}))
+	assert.Equal(t, 2, qt.RequestCost(bulkReq))
+
+	qt = NewQuotaTracker(QuotaSettings{CountBulkAsOne: true})
+	assert.Equal(t, 1, qt.RequestCost(bulkReq))
+}
+
+func TestQuotaTrackerLowPriority(t *testing.T) {
+	qt, _ := newQuotaTrackerWithClock(QuotaSettings{
+		HourlyLimit:            10,
+		LowPriorityReserve:     5,
+		RejectInsteadOfWaiting: true,
+	})
+	lowPriorityCtx := WithPriority(context.Background(), PriorityLow)
+
+	assert.NoError(t, qt.Acquire(lowPriorityCtx, "123", 5))
+
+	err := qt.Acquire(lowPriorityCtx, "123", 1)
+	assert.Error(t, err)
+	assert.Equal(t, HourlyRequestQuota, err.(*ErplyError).Code)
+
+	assert.NoError(t, qt.Acquire(context.Background(), "123", 1))
+	assert.Equal(t, 6, qt.Used("123"))
+}
+
+func TestQuotaTrackerExhausted(t *testing.T) {
+	qt, clock := newQuotaTrackerWithClock(QuotaSettings{RejectInsteadOfWaiting: true})
+	ctx := context.Background()
+
+	qt.MarkExhausted("123")
+	assert.Error(t, qt.Acquire(ctx, "123", 1))
+	assert.NoError(t, qt.Acquire(ctx, "456", 1))
+	assert.Equal(t, 0, qt.Remaining("123"))
+
+	clock.now = time.Date(2020, 1, 1, 11, 0, 0, 0, time.UTC)
+	assert.NoError(t, qt.Acquire(ctx, "123", 1))
+}
+
+func TestQuotaTrackerWaiting(t *testing.T) {
+	qt := NewQuotaTracker(QuotaSettings{})
+	qt.MarkExhausted("123")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := qt.Acquire(ctx, "123", 1)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestPriorityFromContext(t *testing.T) {
+	assert.Equal(t, PriorityNormal, PriorityFromContext(context.Background()))
+	assert.Equal(t, PriorityLow, PriorityFromContext(WithPriority(context.Background(), PriorityLow)))
+}
@@ -0,0 +1,168 @@
+package common
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+//RateLimits describes the allowed speed of requests, zero values mean no limit
+type RateLimits struct {
+	PerSecond int
+	//Burst is the amount of requests which can be sent at once within the per second limit, PerSecond is used if it's not set
+	Burst     int
+	PerMinute int
+	PerHour   int
+}
+
+//RateLimiter limits the speed of outgoing requests
+type RateLimiter interface {
+	//Wait blocks until the next request is allowed or the context is cancelled
+	Wait(ctx context.Context) error
+}
+
+//RateLimiterGetter is implemented by the clients and the data providers of the SDK, it gives the rate limiter
+//of the client. The Lister doesn't throttle the requests of a data provider whose client has a limiter
+type RateLimiterGetter interface {
+	GetRateLimiter() RateLimiter
+}
+
+//RateLimiterOf gives the rate limiter of v if it implements RateLimiterGetter, otherwise nil
+func RateLimiterOf(v interface{}) RateLimiter {
+	if rateLimiterGetter, ok := v.(RateLimiterGetter); ok {
+		return rateLimiterGetter.GetRateLimiter()
+	}
+
+	return nil
+}
+
+type tokenBucket struct {
+	capacity        float64
+	tokens          float64
+	tokensPerSecond float64
+	lastRefill      time.Time
+}
+
+func newTokenBucket(capacity int, period time.Duration, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		capacity:        float64(capacity),
+		tokens:          float64(capacity),
+		tokensPerSecond: float64(capacity) / period.Seconds(),
+		lastRefill:      now,
+	}
+}
+
+func (tb *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(tb.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	tb.tokens += elapsed * tb.tokensPerSecond
+	if tb.tokens > tb.capacity {
+		tb.tokens = tb.capacity
+	}
+	tb.lastRefill = now
+}
+
+func (tb *tokenBucket) delayForToken() time.Duration {
+	if tb.tokens >= 1 {
+		return 0
+	}
+
+	return time.Duration((1 - tb.tokens) / tb.tokensPerSecond * float64(time.Second))
+}
+
+//TokenBucketLimiter implements RateLimiter with a token bucket for each of the configured limits,
+//it's safe for concurrent use and waiting requests are served in the order of arrival
+type TokenBucketLimiter struct {
+	lock    sync.Mutex
+	buckets []*tokenBucket
+	clock   func() time.Time
+}
+
+//NewTokenBucketLimiter creates TokenBucketLimiter, each limiter has its own budget, so share the instance
+//between clients which should have a common limit
+func NewTokenBucketLimiter(limits RateLimits) *TokenBucketLimiter {
+	tbl := &TokenBucketLimiter{
+		clock: time.Now,
+	}
+
+	now := tbl.clock()
+	if limits.PerSecond > 0 {
+		burst := limits.Burst
+		if burst <= 0 {
+			burst = limits.PerSecond
+		}
+		bucket := newTokenBucket(burst, time.Second, now)
+		bucket.tokensPerSecond = float64(limits.PerSecond)
+		tbl.buckets = append(tbl.buckets, bucket)
+	}
+	if limits.PerMinute > 0 {
+		tbl.buckets = append(tbl.buckets, newTokenBucket(limits.PerMinute, time.Minute, now))
+	}
+	if limits.PerHour > 0 {
+		tbl.buckets = append(tbl.buckets, newTokenBucket(limits.PerHour, time.Hour, now))
+	}
+
+	return tbl
+}
+
+//Wait takes a token from each bucket and blocks until all of them are available,
+//if the context is cancelled while waiting the tokens are given back
+func (tbl *TokenBucketLimiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay := tbl.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		tbl.cancelReservation()
+		return ctx.Err()
+	}
+}
+
+//Throttle implements Throttler interface, so the limiter can be given to a Lister
+func (tbl *TokenBucketLimiter) Throttle() {
+	_ = tbl.Wait(context.Background())
+}
+
+func (tbl *TokenBucketLimiter) reserve() time.Duration {
+	tbl.lock.Lock()
+	defer tbl.lock.Unlock()
+
+	now := tbl.clock()
+	var delay time.Duration
+	for _, bucket := range tbl.buckets {
+		bucket.refill(now)
+		if bucketDelay := bucket.delayForToken(); bucketDelay > delay {
+			delay = bucketDelay
+		}
+		bucket.tokens--
+	}
+
+	return delay
+}
+
+func (tbl *TokenBucketLimiter) cancelReservation() {
+	tbl.lock.Lock()
+	defer tbl.lock.Unlock()
+
+	now := tbl.clock()
+	for _, bucket := range tbl.buckets {
+		bucket.refill(now)
+		bucket.tokens++
+		if bucket.tokens > bucket.capacity {
+			bucket.tokens = bucket.capacity
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package common
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type clockMock struct {
+	now time.Time
+}
+
+func (cm *clockMock) Now() time.Time {
+	return cm.now
+}
+
+func newLimiterWithClock(limits RateLimits) (*TokenBucketLimiter, *clockMock) {
+	clock := &clockMock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	tbl := NewTokenBucketLimiter(limits)
+	tbl.clock = clock.Now
+	for _, bucket := range tbl.buckets {
+		bucket.lastRefill = clock.now
+	}
+
+	return tbl, clock
+}
+
+func TestTokenBucketLimiterBurst(t *testing.T) {
+	tbl, clock := newLimiterWithClock(RateLimits{PerSecond: 2, Burst: 4})
+
+	for i := 0; i < 4; i++ {
+		assert.Equal(t, time.Duration(0), tbl.reserve())
+	}
+	assert.Equal(t, 500*time.Millisecond, tbl.reserve())
+	assert.Equal(t, time.Second, tbl.reserve())
+
+	clock.now = clock.now.Add(10 * time.Second)
+	assert.Equal(t, time.Duration(0), tbl.reserve())
+}
+
+func TestTokenBucketLimiterBudgets(t *testing.T) {
+	tbl, clock := newLimiterWithClock(RateLimits{PerSecond: 10, PerMinute: 15})
+
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, time.Duration(0), tbl.reserve())
+	}
+	clock.now = clock.now.Add(time.Second)
+
+	//the per second bucket is full again, but the per minute bucket has only 5.25 tokens
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, time.Duration(0), tbl.reserve())
+	}
+	assert.Equal(t, 3*time.Second, tbl.reserve())
+
+	tbl, _ = newLimiterWithClock(RateLimits{PerHour: 1})
+	assert.Equal(t, time.Duration(0), tbl.reserve())
+	assert.Equal(t, time.Hour, tbl.reserve())
+}
+
+func TestTokenBucketLimiterWithoutLimits(t *testing.T) {
+	tbl := NewTokenBucketLimiter(RateLimits{})
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, tbl.Wait(context.Background()))
+	}
+}
+
+func TestTokenBucketLimiterCancellation(t *testing.T) {
+	tbl := NewTokenBucketLimiter(RateLimits{PerHour: 1})
+
+	assert.NoError(t, tbl.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := tbl.Wait(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	//the cancelled reservation should be given back, so only one request is waiting for a token
+	delay := tbl.reserve()
+	assert.True(t, delay > 59*time.Minute && delay <= time.Hour, delay)
+
+	err = tbl.Wait(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestNewSleepThrottlerGivesSeparateInstances(t *testing.T) {
+	first := NewSleepThrottler(1, NullSleeper)
+	second := NewSleepThrottler(10, NullSleeper)
+
+	assert.Equal(t, 1, first.LimitPerSecond)
+	assert.Equal(t, 10, second.LimitPerSecond)
+}
+
+type rateLimitedClientMock struct {
+	limiter RateLimiter
+}
+
+func (rlcm rateLimitedClientMock) GetRateLimiter() RateLimiter {
+	return rlcm.limiter
+}
+
+func TestListerWithClientRateLimiter(t *testing.T) {
+	settings := ListingSettings{MaxRequestsCountPerSecond: 5}
+	limiter := NewTokenBucketLimiter(RateLimits{PerSecond: 5})
+	dataProvider := struct {
+		*DataProviderMock
+		ListingClient
+	}{
+		DataProviderMock: &DataProviderMock{CountOutputCount: 1, ProductsToRead: []payloadMock{{ID: 1}}},
+		ListingClient:    NewListingClient(rateLimitedClientMock{limiter: limiter}),
+	}
+	assert.Equal(t, limiter, RateLimiterOf(dataProvider))
+
+	lister := NewLister(settings, dataProvider, NullSleeper)
+	assert.Equal(t, 0, lister.reqThrottler.(*SleepThrottler).LimitPerSecond)
+	for item := range lister.Get(context.Background(), map[string]interface{}{}) {
+		assert.NoError(t, item.Err)
+	}
+
+	lister = NewLister(settings, dataProvider.DataProviderMock, NullSleeper)
+	assert.Equal(t, 5, lister.reqThrottler.(*SleepThrottler).LimitPerSecond)
+
+	lister = NewLister(settings, struct {
+		*DataProviderMock
+		ListingClient
+	}{dataProvider.DataProviderMock, NewListingClient(rateLimitedClientMock{})}, NullSleeper)
+	assert.Equal(t, 5, lister.reqThrottler.(*SleepThrottler).LimitPerSecond)
+}
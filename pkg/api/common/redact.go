@@ -0,0 +1,134 @@
+package common
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+//RedactedValue replaces the values of the sensitive parameters in logs and errors
+const RedactedValue = "***"
+
+//SensitiveParams are the request parameters and response fields which hold credentials or secrets, they are sent
+//in the request body instead of the URL and their values are masked everywhere the SDK logs or builds errors.
+//The names are matched case-insensitively
+var SensitiveParams = []string{
+	"sessionKey",
+	"password",
+	"jwt",
+	"token",
+	"identityToken",
+	"partnerKey",
+	"cardCode",
+	"cardNumber",
+}
+
+//IsSensitiveParam tells if the parameter is one of the SensitiveParams
+func IsSensitiveParam(name string) bool {
+	for _, sensitiveParam := range SensitiveParams {
+		if strings.EqualFold(name, sensitiveParam) {
+			return true
+		}
+	}
+
+	return false
+}
+
+//RedactParams gives a copy of the parameters where the values of the sensitive ones are masked
+func RedactParams(params map[string]string) map[string]string {
+	if params == nil {
+		return nil
+	}
+
+	redacted := make(map[string]string, len(params))
+	for name, value := range params {
+		if IsSensitiveParam(name) && value != "" {
+			value = RedactedValue
+		}
+		redacted[name] = value
+	}
+
+	return redacted
+}
+
+//RedactValues gives a copy of the URL values where the values of the sensitive parameters are masked
+func RedactValues(values url.Values) url.Values {
+	if values == nil {
+		return nil
+	}
+
+	redacted := make(url.Values, len(values))
+	for name, vals := range values {
+		redactedVals := make([]string, len(vals))
+		for i, value := range vals {
+			if IsSensitiveParam(name) && value != "" {
+				value = RedactedValue
+			}
+			redactedVals[i] = value
+		}
+		redacted[name] = redactedVals
+	}
+
+	return redacted
+}
+
+//RedactBulkInputs gives a copy of the bulk inputs where the values of the sensitive filters are masked
+func RedactBulkInputs(inputs []BulkInput) []BulkInput {
+	if inputs == nil {
+		return nil
+	}
+
+	redacted := make([]BulkInput, 0, len(inputs))
+	for _, input := range inputs {
+		filters := make(map[string]interface{}, len(input.Filters))
+		for name, value := range input.Filters {
+			if IsSensitiveParam(name) {
+				value = RedactedValue
+			}
+			filters[name] = value
+		}
+		redacted = append(redacted, BulkInput{MethodName: input.MethodName, Filters: filters})
+	}
+
+	return redacted
+}
+
+//RedactText masks the values of the sensitive parameters in a text like a URL, a query string or a JSON body,
+//e.g. "sessionKey=abc" gives "sessionKey=***" and `"password":"abc"` gives `"password":"***"`
+func RedactText(text string) string {
+	queryRegexp, jsonRegexp := sensitiveRegexps()
+
+	text = queryRegexp.ReplaceAllString(text, "${1}${2}="+RedactedValue)
+	text = jsonRegexp.ReplaceAllString(text, `${1}"${2}"${3}"`+RedactedValue+`"`)
+
+	return text
+}
+
+var (
+	sensitiveRegexpsLock    sync.Mutex
+	sensitiveRegexpsPattern string
+	sensitiveQueryRegexp    *regexp.Regexp
+	sensitiveJSONRegexp     *regexp.Regexp
+)
+
+//sensitiveRegexps gives the expressions which match the sensitive parameters in query strings and JSON,
+//they are rebuilt when SensitiveParams is changed by the caller
+func sensitiveRegexps() (queryRegexp, jsonRegexp *regexp.Regexp) {
+	names := make([]string, 0, len(SensitiveParams))
+	for _, sensitiveParam := range SensitiveParams {
+		names = append(names, regexp.QuoteMeta(sensitiveParam))
+	}
+	namesPattern := strings.Join(names, "|")
+
+	sensitiveRegexpsLock.Lock()
+	defer sensitiveRegexpsLock.Unlock()
+
+	if sensitiveQueryRegexp == nil || namesPattern != sensitiveRegexpsPattern {
+		sensitiveRegexpsPattern = namesPattern
+		sensitiveQueryRegexp = regexp.MustCompile(`(?i)(^|[?&\s"'])(` + namesPattern + `)=[^&\s"']*`)
+		sensitiveJSONRegexp = regexp.MustCompile(`(?i)(^|[{,\s])"(` + namesPattern + `)"(\s*:\s*)(?:"(?:[^"\\]|\\.)*"|[^,}\]\s]+)`)
+	}
+
+	return sensitiveQueryRegexp, sensitiveJSONRegexp
+}
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"testing"
+)
+
+func TestRedactText(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    `Post "https://abc.erply.com/api/?clientCode=123&sessionKey=secret&request=getProducts": dial tcp`,
+			expected: `Post "https://abc.erply.com/api/?clientCode=123&sessionKey=***&request=getProducts": dial tcp`,
+		},
+		{
+			input:    `password=secret&username=user`,
+			expected: `password=***&username=user`,
+		},
+		{
+			input:    `{"records":[{"sessionKey":"secret","token": "a\"b","userName":"user","cardCode":1234}]}`,
+			expected: `{"records":[{"sessionKey":"***","token": "***","userName":"user","cardCode":"***"}]}`,
+		},
+		{
+			input:    `oldSessionKey=value&sessionKeyType=value`,
+			expected: `oldSessionKey=value&sessionKeyType=value`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, RedactText(testCase.input))
+	}
+}
+
+func TestRedactParams(t *testing.T) {
+	params := map[string]string{"sessionKey": "secret", "JWT": "secret", "request": "getProducts", "password": ""}
+	assert.Equal(t, map[string]string{"sessionKey": RedactedValue, "JWT": RedactedValue, "request": "getProducts", "password": ""}, RedactParams(params))
+	assert.Equal(t, "secret", params["sessionKey"])
+
+	values := url.Values{"partnerKey": {"secret"}, "clientCode": {"123"}}
+	assert.Equal(t, url.Values{"partnerKey": {RedactedValue}, "clientCode": {"123"}}, RedactValues(values))
+	assert.Equal(t, "secret", values.Get("partnerKey"))
+
+	inputs := []BulkInput{{MethodName: "savePayment", Filters: map[string]interface{}{"cardNumber": "4111", "sum": "10"}}}
+	assert.Equal(
+		t,
+		[]BulkInput{{MethodName: "savePayment", Filters: map[string]interface{}{"cardNumber": RedactedValue, "sum": "10"}}},
+		RedactBulkInputs(inputs),
+	)
+	assert.Equal(t, "4111", inputs[0].Filters["cardNumber"])
+}
+
+func TestErplyErrorIsRedacted(t *testing.T) {
+	err := NewFromError("getProducts request failed", errors.New(`Post "https://abc.erply.com/api/?sessionKey=secret"`), 0)
+	assert.NotContains(t, err.Error(), "secret")
+	assert.Contains(t, err.Error(), "sessionKey=***")
+}
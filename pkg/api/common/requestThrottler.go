@@ -12,8 +12,6 @@ type Throttler interface {
 
 type Sleeper func(sleepTime time.Duration)
 
-var sleepThrottler *SleepThrottler
-
 //SleepThrottler implements sleeping logic for requests throttling
 type SleepThrottler struct {
 	LimitPerSecond int
@@ -23,19 +21,16 @@ type SleepThrottler struct {
 	lock           sync.Mutex
 }
 
-//NewSleepThrottler creates SleepThrottler
+//NewSleepThrottler creates SleepThrottler, each call gives a new instance with its own counter,
+//so share the instance between listers which should have a common limit
 func NewSleepThrottler(limitPerSecond int, sl Sleeper) *SleepThrottler {
-	if sleepThrottler == nil {
-		sleepThrottler = &SleepThrottler{
-			LimitPerSecond: limitPerSecond,
-			LastTimestamp:  time.Now().Unix(),
-			Count:          0,
-			sl:             sl,
-			lock:           sync.Mutex{},
-		}
+	return &SleepThrottler{
+		LimitPerSecond: limitPerSecond,
+		LastTimestamp:  time.Now().Unix(),
+		Count:          0,
+		sl:             sl,
+		lock:           sync.Mutex{},
 	}
-
-	return sleepThrottler
 }
 
 //Throttle implements throttling method
@@ -0,0 +1,207 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+)
+
+//DefaultRetryableCodes are the error codes of transient failures which are worth repeating
+var DefaultRetryableCodes = []ApiError{ServerMaintenance, AccountDbConnError, DbError}
+
+//DefaultUnprocessedCodes are the error codes which mean that the request was rejected before it was processed,
+//so it's safe to repeat even not idempotent requests
+var DefaultUnprocessedCodes = []ApiError{ServerMaintenance, AccountDbConnError}
+
+var idempotentMethodPrefixes = []string{"get", "verify", "calculate"}
+
+//RetryPolicy describes when and how often failed requests are repeated
+type RetryPolicy struct {
+	//MaxAttempts is the total amount of attempts including the first one, values lower than 2 disable retries
+	MaxAttempts int
+	//InitialBackoff is the waiting time before the first retry
+	InitialBackoff time.Duration
+	//MaxBackoff limits the waiting time between attempts, 0 means no limit
+	MaxBackoff time.Duration
+	//Multiplier increases the waiting time after each attempt, 2 is used if it's not set
+	Multiplier float64
+	//Jitter is the fraction of the waiting time which is randomized, the value should be between 0 and 1
+	Jitter float64
+	//RetryableCodes are the error codes to retry, DefaultRetryableCodes are used if it's nil
+	RetryableCodes []ApiError
+	//UnprocessedCodes are the error codes which allow to retry not idempotent requests,
+	//DefaultUnprocessedCodes are used if it's nil
+	UnprocessedCodes []ApiError
+	//RetryNonIdempotent allows to retry save, delete and other not idempotent requests on any retryable failure,
+	//by default they are repeated only if the API didn't process them
+	RetryNonIdempotent bool
+}
+
+//Backoff gives the waiting time before the next attempt, the attempt number starts from 1
+func (rp RetryPolicy) Backoff(attempt int) time.Duration {
+	multiplier := rp.Multiplier
+	if multiplier == 0 {
+		multiplier = 2
+	}
+
+	backoff := float64(rp.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if rp.MaxBackoff > 0 && backoff > float64(rp.MaxBackoff) {
+		backoff = float64(rp.MaxBackoff)
+	}
+
+	if rp.Jitter > 0 {
+		backoff = backoff*(1-rp.Jitter) + rand.Float64()*backoff*rp.Jitter
+	}
+
+	return time.Duration(backoff)
+}
+
+//ShouldRetry decides if the request should be repeated after the given response or error. A bulk call with an ok
+//status is repeated as a whole if all failed sub-requests are retryable and repeating the successful ones is safe too,
+//see RetryableBulkItems for repeating only the failed sub-requests
+func (rp RetryPolicy) ShouldRetry(req *Request, resp *Response, err error) bool {
+	if rp.MaxAttempts < 2 {
+		return false
+	}
+
+	idempotent := rp.RetryNonIdempotent || IsIdempotentRequest(req)
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return idempotent
+		}
+		return false
+	}
+
+	if resp == nil || resp.Status == nil {
+		return false
+	}
+
+	if isOKStatus(resp.Status) {
+		if !req.IsBulk() || !idempotent {
+			return false
+		}
+		failedCount := 0
+		for _, bulkStatus := range resp.BulkStatuses {
+			if !isOKStatus(&bulkStatus.Status) {
+				failedCount++
+			}
+		}
+		return failedCount > 0 && len(rp.RetryableBulkItems(req, resp)) == failedCount
+	}
+
+	return rp.shouldRetryCode(resp.Status.ErrorCode, idempotent)
+}
+
+//RetryableBulkItems gives the indexes of the failed sub-requests of a bulk call with an ok status which should be
+//repeated: their error code is retryable and the sub-request is idempotent or wasn't processed by the API.
+//A bulk call which refers to CurrentInvoiceID gives none, its sub-requests can't be repeated without the
+//saveSalesDocument they depend on
+func (rp RetryPolicy) RetryableBulkItems(req *Request, resp *Response) []int {
+	if rp.MaxAttempts < 2 || !req.IsBulk() || resp == nil || resp.Status == nil || !isOKStatus(resp.Status) {
+		return nil
+	}
+	if refersToCurrentInvoice(req.BulkInputs) {
+		return nil
+	}
+
+	var indexes []int
+	for i, bulkStatus := range resp.BulkStatuses {
+		if i >= len(req.BulkInputs) || isOKStatus(&bulkStatus.Status) {
+			continue
+		}
+
+		idempotent := rp.RetryNonIdempotent || isIdempotentMethod(req.BulkInputs[i].MethodName)
+		if rp.shouldRetryCode(bulkStatus.ErrorCode, idempotent) {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
+func refersToCurrentInvoice(inputs []BulkInput) bool {
+	for _, input := range inputs {
+		for _, value := range input.Filters {
+			if fmt.Sprint(value) == CurrentInvoiceID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (rp RetryPolicy) shouldRetryCode(code ApiError, idempotent bool) bool {
+	if !containsCode(rp.retryableCodes(), code) {
+		return false
+	}
+
+	return idempotent || containsCode(rp.unprocessedCodes(), code)
+}
+
+func (rp RetryPolicy) retryableCodes() []ApiError {
+	if rp.RetryableCodes == nil {
+		return DefaultRetryableCodes
+	}
+	return rp.RetryableCodes
+}
+
+func (rp RetryPolicy) unprocessedCodes() []ApiError {
+	if rp.UnprocessedCodes == nil {
+		return DefaultUnprocessedCodes
+	}
+	return rp.UnprocessedCodes
+}
+
+//IsIdempotentRequest tells if the request only reads data, bulk requests are idempotent if all sub-requests are
+func IsIdempotentRequest(req *Request) bool {
+	if !req.IsBulk() {
+		return isIdempotentMethod(req.Method)
+	}
+
+	for _, bulkInput := range req.BulkInputs {
+		if !isIdempotentMethod(bulkInput.MethodName) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isIdempotentMethod(method string) bool {
+	for _, prefix := range idempotentMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isOKStatus(status *Status) bool {
+	return strings.EqualFold(status.ResponseStatus, "ok")
+}
+
+func containsCode(codes []ApiError, code ApiError) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,140 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	rp := RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Second,
+	}
+
+	assert.Equal(t, time.Second, rp.Backoff(1))
+	assert.Equal(t, 2*time.Second, rp.Backoff(2))
+	assert.Equal(t, 4*time.Second, rp.Backoff(3))
+	assert.Equal(t, 5*time.Second, rp.Backoff(4))
+
+	rp.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		backoff := rp.Backoff(2)
+		assert.True(t, backoff >= time.Second && backoff <= 2*time.Second, backoff)
+	}
+}
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	rp := RetryPolicy{MaxAttempts: 3}
+
+	getReq := &Request{Method: "getProducts"}
+	saveReq := &Request{Method: "saveProduct"}
+	bulkGetReq := &Request{BulkInputs: []BulkInput{{MethodName: "getProducts"}, {MethodName: "getCustomers"}}}
+	bulkSaveReq := &Request{BulkInputs: []BulkInput{{MethodName: "getProducts"}, {MethodName: "saveProduct"}}}
+
+	respWithCode := func(code ApiError) *Response {
+		return &Response{Status: &Status{ResponseStatus: "error", ErrorCode: code}}
+	}
+
+	timeoutErr := NewFromError("getProducts request failed", &net.DNSError{IsTimeout: true}, 0)
+	dialErr := NewFromError("saveProduct request failed", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, 0)
+
+	testCases := []struct {
+		name          string
+		req           *Request
+		resp          *Response
+		err           error
+		expectedRetry bool
+	}{
+		{"ok response", getReq, &Response{Status: &Status{ResponseStatus: "ok"}}, nil, false},
+		{"maintenance on get", getReq, respWithCode(ServerMaintenance), nil, true},
+		{"db error on get", getReq, respWithCode(DbError), nil, true},
+		{"db error on save", saveReq, respWithCode(DbError), nil, false},
+		{"maintenance on save", saveReq, respWithCode(ServerMaintenance), nil, true},
+		{"not retryable code", getReq, respWithCode(InvalidValue), nil, false},
+		{"db error on bulk get", bulkGetReq, respWithCode(DbError), nil, true},
+		{"db error on bulk save", bulkSaveReq, respWithCode(DbError), nil, false},
+		{"timeout on get", getReq, nil, timeoutErr, true},
+		{"timeout on save", saveReq, nil, timeoutErr, false},
+		{"dial error on save", saveReq, nil, dialErr, true},
+		{"cancelled context", getReq, nil, context.Canceled, false},
+		{"other error", getReq, nil, errors.New("some error"), false},
+		{"non json response", getReq, &Response{}, nil, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedRetry, rp.ShouldRetry(testCase.req, testCase.resp, testCase.err))
+		})
+	}
+
+	rp.RetryNonIdempotent = true
+	assert.True(t, rp.ShouldRetry(saveReq, respWithCode(DbError), nil))
+
+	rp.RetryableCodes = []ApiError{InvalidValue}
+	assert.True(t, rp.ShouldRetry(getReq, respWithCode(InvalidValue), nil))
+	assert.False(t, rp.ShouldRetry(getReq, respWithCode(DbError), nil))
+
+	assert.False(t, RetryPolicy{MaxAttempts: 1}.ShouldRetry(getReq, respWithCode(DbError), nil))
+}
+
+func TestRetryPolicyBulkItems(t *testing.T) {
+	rp := RetryPolicy{MaxAttempts: 3}
+
+	bulkGetReq := &Request{BulkInputs: []BulkInput{{MethodName: "getProducts"}, {MethodName: "getCustomers"}, {MethodName: "getProducts"}}}
+	bulkSaveReq := &Request{BulkInputs: []BulkInput{{MethodName: "saveProduct"}, {MethodName: "getProducts"}, {MethodName: "saveProduct"}}}
+	invoiceReq := &Request{BulkInputs: []BulkInput{
+		{MethodName: "saveSalesDocument"},
+		{MethodName: "savePayment", Filters: map[string]interface{}{"documentID": CurrentInvoiceID}},
+		{MethodName: "getProducts"},
+	}}
+
+	bulkResp := func(codes ...ApiError) *Response {
+		resp := &Response{Status: &Status{ResponseStatus: "ok"}}
+		for _, code := range codes {
+			status := StatusBulk{}
+			status.ResponseStatus = "ok"
+			if code != 0 {
+				status.ResponseStatus = "error"
+				status.ErrorCode = code
+			}
+			resp.BulkStatuses = append(resp.BulkStatuses, status)
+		}
+		return resp
+	}
+
+	testCases := []struct {
+		name          string
+		req           *Request
+		resp          *Response
+		expectedRetry bool
+		expectedItems []int
+	}{
+		{"all ok", bulkGetReq, bulkResp(0, 0, 0), false, nil},
+		{"db errors on gets", bulkGetReq, bulkResp(DbError, 0, DbError), true, []int{0, 2}},
+		{"not retryable item", bulkGetReq, bulkResp(DbError, InvalidValue, 0), false, []int{0}},
+		{"db error on get with saves", bulkSaveReq, bulkResp(0, DbError, 0), false, []int{1}},
+		{"db error on save", bulkSaveReq, bulkResp(DbError, 0, 0), false, nil},
+		{"maintenance on save", bulkSaveReq, bulkResp(ServerMaintenance, 0, 0), false, []int{0}},
+		{"maintenance on payment of current invoice", invoiceReq, bulkResp(0, ServerMaintenance, 0), false, nil},
+		{"db error on get with current invoice", invoiceReq, bulkResp(0, 0, DbError), false, nil},
+		{"failed bulk call", bulkGetReq, &Response{Status: &Status{ResponseStatus: "error", ErrorCode: DbError}}, true, nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedRetry, rp.ShouldRetry(testCase.req, testCase.resp, nil))
+			assert.Equal(t, testCase.expectedItems, rp.RetryableBulkItems(testCase.req, testCase.resp))
+		})
+	}
+
+	rp.RetryNonIdempotent = true
+	assert.True(t, rp.ShouldRetry(bulkSaveReq, bulkResp(DbError, 0, 0), nil))
+	assert.Equal(t, []int{0}, rp.RetryableBulkItems(bulkSaveReq, bulkResp(DbError, 0, 0)))
+
+	assert.Nil(t, RetryPolicy{MaxAttempts: 1}.RetryableBulkItems(bulkGetReq, bulkResp(DbError, 0, 0)))
+}
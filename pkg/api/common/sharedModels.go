@@ -6,19 +6,19 @@ type (
 
 	//Address from getAddresses
 	Address struct {
-		AddressID        int    `json:"addressID"`
-		OwnerID          int    `json:"ownerID"`
-		TypeID           int    `json:"typeID"`
-		TypeActivelyUsed int    `json:"typeActivelyUsed"`
-		Added            int64  `json:"added"`
-		Address2         string `json:"address2"`
-		TypeName         string `json:"typeName"`
-		Address          string `json:"address"`
-		Street           string `json:"street"`
-		PostalCode       string `json:"postalCode"`
-		City             string `json:"city"`
-		State            string `json:"state"`
-		Country          string `json:"country"`
+		AddressID        int       `json:"addressID"`
+		OwnerID          int       `json:"ownerID"`
+		TypeID           int       `json:"typeID"`
+		TypeActivelyUsed int       `json:"typeActivelyUsed"`
+		Added            Timestamp `json:"added"`
+		Address2         string    `json:"address2"`
+		TypeName         string    `json:"typeName"`
+		Address          string    `json:"address"`
+		Street           string    `json:"street"`
+		PostalCode       string    `json:"postalCode"`
+		City             string    `json:"city"`
+		State            string    `json:"state"`
+		Country          string    `json:"country"`
 		LastModified
 		Attributes
 	}
@@ -43,8 +43,8 @@ type (
 	}
 
 	LastModified struct {
-		LastModified           int64  `json:"lastModified"`
-		LastModifierEmployeeID int64  `json:"lastModifierEmployeeID"`
-		LastModifierUsername   string `json:"lastModifierUsername"`
+		LastModified           Timestamp `json:"lastModified"`
+		LastModifierEmployeeID int64     `json:"lastModifierEmployeeID"`
+		LastModifierUsername   string    `json:"lastModifierUsername"`
 	}
 )
@@ -0,0 +1,231 @@
+package common
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//DateLayout is the format of the dates in ERPLY requests and responses
+const DateLayout = "2006-01-02"
+
+//DateTimeLayout is the format of the date-times without a time zone in ERPLY responses
+const DateTimeLayout = "2006-01-02 15:04:05"
+
+//dateTimeLayouts are tried in order when a timestamp or a date is given as a string
+var dateTimeLayouts = []string{
+	DateTimeLayout,
+	"2006-01-02T15:04:05",
+	time.RFC3339,
+	DateLayout,
+}
+
+//locationOrUTC gives UTC for a nil location
+func locationOrUTC(loc *time.Location) *time.Location {
+	if loc == nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+//isZeroDateValue tells if the raw value means "no date" in ERPLY responses
+func isZeroDateValue(raw string) bool {
+	return raw == "" || raw == "0" || strings.HasPrefix(raw, "0000-00-00")
+}
+
+//parseDateTime parses the string with one of the known layouts, the values without a zone keep their wall time in UTC
+//because the decoding doesn't know the location of the account
+func parseDateTime(raw string) (time.Time, error) {
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.ParseInLocation(layout, raw, time.UTC); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("cannot parse %q as a date", raw)
+}
+
+//parseUnixSeconds parses an integer or an integral float number of seconds
+func parseUnixSeconds(raw string) (int64, bool) {
+	if intVal, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return intVal, true
+	}
+
+	floatVal, err := strconv.ParseFloat(raw, 64)
+	if err != nil || floatVal != math.Trunc(floatVal) {
+		return 0, false
+	}
+
+	return int64(floatVal), true
+}
+
+//Timestamp is a unix timestamp in seconds, it's decoded from a JSON number, a numeric string or
+//a date-time string like "2020-01-31 13:45:00". ERPLY gives such strings in the local time of the account,
+//the decoding doesn't know its location, so the wall time is kept as it is in UTC, use WallTime for them.
+//Empty strings, nulls and zero dates give 0. It's encoded as a number in JSON and in request filters
+type Timestamp int64
+
+//NewTimestamp gives the timestamp of the time, the zero time gives 0
+func NewTimestamp(t time.Time) Timestamp {
+	if t.IsZero() {
+		return 0
+	}
+
+	return Timestamp(t.Unix())
+}
+
+//Time gives the time in the location of the account, a nil location means UTC and 0 gives the zero time.
+//The location of a client is given by its Location method
+func (ts Timestamp) Time(loc *time.Location) time.Time {
+	if ts == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(ts), 0).In(locationOrUTC(loc))
+}
+
+//WallTime gives the time of a timestamp decoded from a date-time string without a zone, its wall time is taken
+//in the location, e.g. the one given by the Location method of the client, a nil location means UTC
+func (ts Timestamp) WallTime(loc *time.Location) time.Time {
+	if ts == 0 {
+		return time.Time{}
+	}
+
+	wall := time.Unix(int64(ts), 0).UTC()
+
+	return time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, locationOrUTC(loc))
+}
+
+//Unix gives the amount of seconds since the unix epoch
+func (ts Timestamp) Unix() int64 {
+	return int64(ts)
+}
+
+func (ts Timestamp) IsZero() bool {
+	return ts == 0
+}
+
+func (ts Timestamp) String() string {
+	return strconv.FormatInt(int64(ts), 10)
+}
+
+func (ts *Timestamp) UnmarshalJSON(data []byte) error {
+	raw, err := unquoteFlexValue(data)
+	if err != nil {
+		return err
+	}
+	if isZeroDateValue(raw) {
+		*ts = 0
+		return nil
+	}
+
+	if seconds, ok := parseUnixSeconds(raw); ok {
+		*ts = Timestamp(seconds)
+		return nil
+	}
+
+	t, err := parseDateTime(raw)
+	if err != nil {
+		return fmt.Errorf("cannot decode %s as a timestamp", string(data))
+	}
+	*ts = NewTimestamp(t)
+
+	return nil
+}
+
+func (ts Timestamp) MarshalJSON() ([]byte, error) {
+	return []byte(ts.String()), nil
+}
+
+func (ts Timestamp) FilterValue() string {
+	if ts == 0 {
+		return ""
+	}
+	return ts.String()
+}
+
+//Date is a calendar date in the "2006-01-02" format, it's decoded from a date string, a date-time string or
+//a unix timestamp. A date-time string gives its own date, a unix timestamp gives the UTC date because
+//the decoding doesn't know the location of the account, use NewDate with it. Empty strings, nulls and "0000-00-00"
+//give an empty date. It's encoded as the date string in JSON and in request filters
+type Date string
+
+//NewDate gives the date of the time in the location, a nil location means UTC and the zero time gives an empty date
+func NewDate(t time.Time, loc *time.Location) Date {
+	if t.IsZero() {
+		return ""
+	}
+
+	return Date(t.In(locationOrUTC(loc)).Format(DateLayout))
+}
+
+//Time gives the midnight of the date in the location, a nil location means UTC and an empty or invalid date
+//gives the zero time
+func (d Date) Time(loc *time.Location) time.Time {
+	if d == "" {
+		return time.Time{}
+	}
+
+	t, err := time.ParseInLocation(DateLayout, string(d), locationOrUTC(loc))
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+//At gives the time of the date combined with a clock value like "13:45:00" or "13:45" in the location,
+//a nil location means UTC. It's meant for responses which give the date and the time in separate fields
+func (d Date) At(clock string, loc *time.Location) (time.Time, error) {
+	if d == "" {
+		return time.Time{}, nil
+	}
+
+	clock = strings.TrimSpace(clock)
+	if clock == "" {
+		clock = "00:00:00"
+	} else if strings.Count(clock, ":") == 1 {
+		clock += ":00"
+	}
+
+	return time.ParseInLocation(DateTimeLayout, string(d)+" "+clock, locationOrUTC(loc))
+}
+
+func (d Date) IsZero() bool {
+	return d == ""
+}
+
+func (d Date) String() string {
+	return string(d)
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	raw, err := unquoteFlexValue(data)
+	if err != nil {
+		return err
+	}
+	if isZeroDateValue(raw) {
+		*d = ""
+		return nil
+	}
+
+	if seconds, ok := parseUnixSeconds(raw); ok {
+		*d = NewDate(time.Unix(seconds, 0), time.UTC)
+		return nil
+	}
+
+	t, err := parseDateTime(raw)
+	if err != nil {
+		return fmt.Errorf("cannot decode %s as a date", string(data))
+	}
+	*d = Date(t.Format(DateLayout))
+
+	return nil
+}
+
+func (d Date) FilterValue() string {
+	return string(d)
+}
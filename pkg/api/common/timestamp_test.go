@@ -0,0 +1,134 @@
+package common
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestTimestampDecoding(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected Timestamp
+	}{
+		{input: `1577836800`, expected: 1577836800},
+		{input: `"1577836800"`, expected: 1577836800},
+		{input: `1577836800.0`, expected: 1577836800},
+		{input: `"2020-01-01 00:00:00"`, expected: 1577836800},
+		{input: `"2020-01-01T00:00:00"`, expected: 1577836800},
+		{input: `"2020-01-01T02:00:00+02:00"`, expected: 1577836800},
+		{input: `"2020-01-01"`, expected: 1577836800},
+		{input: `0`, expected: 0},
+		{input: `""`, expected: 0},
+		{input: `null`, expected: 0},
+		{input: `"0000-00-00 00:00:00"`, expected: 0},
+	}
+
+	for _, testCase := range testCases {
+		var ts Timestamp
+		assert.NoError(t, json.Unmarshal([]byte(testCase.input), &ts), testCase.input)
+		assert.Equal(t, testCase.expected, ts, testCase.input)
+	}
+
+	var ts Timestamp
+	assert.Error(t, json.Unmarshal([]byte(`"yesterday"`), &ts))
+}
+
+func TestTimestampConversion(t *testing.T) {
+	tallinn, err := time.LoadLocation("Europe/Tallinn")
+	assert.NoError(t, err)
+
+	ts := Timestamp(1577836800)
+	assert.Equal(t, "2020-01-01 02:00:00", ts.Time(tallinn).Format(DateTimeLayout))
+	assert.Equal(t, tallinn, ts.Time(tallinn).Location())
+	assert.Equal(t, ts, NewTimestamp(ts.Time(tallinn)))
+	assert.Equal(t, "2020-01-01 00:00:00", ts.Time(nil).Format(DateTimeLayout))
+	assert.Equal(t, time.UTC, ts.Time(nil).Location())
+
+	var wallTS Timestamp
+	assert.NoError(t, json.Unmarshal([]byte(`"2020-01-01 02:00:00"`), &wallTS))
+	assert.Equal(t, "2020-01-01 02:00:00", wallTS.Time(nil).Format(DateTimeLayout))
+	assert.Equal(t, ts.Time(tallinn), wallTS.WallTime(tallinn))
+	assert.True(t, ts.Time(nil).Equal(wallTS.WallTime(tallinn)))
+	assert.True(t, Timestamp(0).WallTime(tallinn).IsZero())
+
+	assert.True(t, Timestamp(0).Time(tallinn).IsZero())
+	assert.Equal(t, Timestamp(0), NewTimestamp(time.Time{}))
+
+	jsonRaw, err := json.Marshal(struct {
+		LastModified Timestamp `json:"lastModified"`
+	}{LastModified: ts})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"lastModified":1577836800}`, string(jsonRaw))
+
+	filters, err := EncodeFilters(struct {
+		ChangedSince Timestamp `json:"changedSince"`
+		AddedSince   Timestamp `json:"addedSince"`
+	}{ChangedSince: ts})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"changedSince": "1577836800"}, filters)
+}
+
+func TestDateDecoding(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected Date
+	}{
+		{input: `"2020-01-31"`, expected: "2020-01-31"},
+		{input: `"2020-01-31 23:30:00"`, expected: "2020-01-31"},
+		{input: `1577836800`, expected: "2020-01-01"},
+		{input: `"1577833200"`, expected: "2019-12-31"},
+		{input: `""`, expected: ""},
+		{input: `null`, expected: ""},
+		{input: `"0000-00-00"`, expected: ""},
+	}
+
+	for _, testCase := range testCases {
+		var d Date
+		assert.NoError(t, json.Unmarshal([]byte(testCase.input), &d), testCase.input)
+		assert.Equal(t, testCase.expected, d, testCase.input)
+	}
+
+	var d Date
+	assert.Error(t, json.Unmarshal([]byte(`"31.01.2020"`), &d))
+}
+
+func TestDateConversion(t *testing.T) {
+	tallinn, err := time.LoadLocation("Europe/Tallinn")
+	assert.NoError(t, err)
+
+	d := Date("2020-01-31")
+	assert.Equal(t, time.Date(2020, 1, 31, 0, 0, 0, 0, tallinn), d.Time(tallinn))
+	assert.Equal(t, time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC), d.Time(nil))
+	assert.Equal(t, d, NewDate(d.Time(tallinn), tallinn))
+	assert.Equal(t, Date("2020-02-01"), NewDate(time.Date(2020, 1, 31, 23, 30, 0, 0, time.UTC), tallinn))
+	assert.Equal(t, Date("2020-01-31"), NewDate(time.Date(2020, 1, 31, 23, 30, 0, 0, time.UTC), nil))
+
+	dateTime, err := d.At("13:45", tallinn)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 1, 31, 13, 45, 0, 0, tallinn), dateTime)
+
+	dateTime, err = d.At("13:45:10", tallinn)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 1, 31, 13, 45, 10, 0, tallinn), dateTime)
+
+	_, err = d.At("later", tallinn)
+	assert.Error(t, err)
+
+	assert.True(t, Date("").Time(tallinn).IsZero())
+	assert.Equal(t, Date(""), NewDate(time.Time{}, tallinn))
+
+	jsonRaw, err := json.Marshal(struct {
+		Date Date `json:"date"`
+	}{Date: d})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"date":"2020-01-31"}`, string(jsonRaw))
+
+	filters, err := EncodeFilters(struct {
+		DateFrom Date `json:"dateFrom"`
+		DateTo   Date `json:"dateTo"`
+	}{DateFrom: d})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"dateFrom": "2020-01-31"}, filters)
+}
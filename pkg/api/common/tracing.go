@@ -0,0 +1,100 @@
+package common
+
+import (
+	"context"
+)
+
+//The names of the spans started by the SDK
+const (
+	SpanSession        = "erply.session"
+	SpanHTTPCall       = "erply.http"
+	SpanBulkSubRequest = "erply.bulkSubRequest"
+	SpanListerPage     = "erply.listerPage"
+)
+
+//The keys of the attributes which are set on the spans
+const (
+	AttrClientCode      = "erply.clientCode"
+	AttrMethod          = "erply.method"
+	AttrHTTPStatus      = "http.status_code"
+	AttrErrorCode       = "erply.errorCode"
+	AttrGenerationTime  = "erply.generationTime"
+	AttrBulkSize        = "erply.bulkSize"
+	AttrSubRequestIndex = "erply.subRequestIndex"
+	AttrFetcher         = "erply.fetcher"
+	AttrFirstPage       = "erply.firstPage"
+	AttrPagesCount      = "erply.pagesCount"
+	AttrItemsCount      = "erply.itemsCount"
+)
+
+//Attribute is a key/value pair attached to a span
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+//Attr is a shortcut for creating an Attribute
+func Attr(key string, value interface{}) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+//Tracer starts spans, implement it to connect the SDK to the tracer of your service.
+//StartSpan gives a context which should carry the new span, the spans started with that context are its children
+type Tracer interface {
+	StartSpan(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span)
+}
+
+//Span is an operation started by a Tracer
+type Span interface {
+	SetAttributes(attributes ...Attribute)
+	//End finishes the span, err is the failure of the operation if any
+	End(err error)
+}
+
+//TracerGetter is implemented by the clients and the data providers of the SDK, it gives the tracer set
+//in ClientBuilder.Tracer. The Lister takes the tracer of its data provider through it
+type TracerGetter interface {
+	GetTracer() Tracer
+}
+
+//TracerOf gives the tracer of v if it implements TracerGetter, otherwise nil
+func TracerOf(v interface{}) Tracer {
+	if tracerGetter, ok := v.(TracerGetter); ok {
+		return tracerGetter.GetTracer()
+	}
+
+	return nil
+}
+
+type tracerCtxKey struct{}
+
+//ContextWithTracer gives a context which makes the clients and the Lister report spans to the tracer
+func ContextWithTracer(ctx context.Context, tracer Tracer) context.Context {
+	return context.WithValue(ctx, tracerCtxKey{}, tracer)
+}
+
+//TracerFromContext gives the tracer added with ContextWithTracer or nil
+func TracerFromContext(ctx context.Context) Tracer {
+	if ctx == nil {
+		return nil
+	}
+	tracer, _ := ctx.Value(tracerCtxKey{}).(Tracer)
+
+	return tracer
+}
+
+//StartSpan starts a span with the tracer of the context, if there is no tracer a no-op span is given
+func StartSpan(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span) {
+	tracer := TracerFromContext(ctx)
+	if tracer == nil {
+		return ctx, nopSpan{}
+	}
+
+	return tracer.StartSpan(ctx, name, attributes...)
+}
+
+type nopSpan struct{}
+
+func (ns nopSpan) SetAttributes(attributes ...Attribute) {}
+
+func (ns nopSpan) End(err error) {}
@@ -0,0 +1,128 @@
+package common
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+type spanMock struct {
+	name       string
+	parent     *spanMock
+	attributes map[string]interface{}
+	ended      bool
+	err        error
+}
+
+func (sm *spanMock) SetAttributes(attributes ...Attribute) {
+	for _, attribute := range attributes {
+		sm.attributes[attribute.Key] = attribute.Value
+	}
+}
+
+func (sm *spanMock) End(err error) {
+	sm.ended = true
+	sm.err = err
+}
+
+type spanCtxKey struct{}
+
+type tracerMock struct {
+	lock  sync.Mutex
+	spans []*spanMock
+}
+
+func (tm *tracerMock) StartSpan(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span) {
+	tm.lock.Lock()
+	defer tm.lock.Unlock()
+
+	parent, _ := ctx.Value(spanCtxKey{}).(*spanMock)
+	span := &spanMock{name: name, parent: parent, attributes: map[string]interface{}{}}
+	span.SetAttributes(attributes...)
+	tm.spans = append(tm.spans, span)
+
+	return context.WithValue(ctx, spanCtxKey{}, span), span
+}
+
+func TestStartSpanWithoutTracer(t *testing.T) {
+	ctx := context.Background()
+	spanCtx, span := StartSpan(ctx, SpanHTTPCall, Attr(AttrMethod, "getProducts"))
+	assert.Equal(t, ctx, spanCtx)
+	span.SetAttributes(Attr(AttrHTTPStatus, 200))
+	span.End(nil)
+
+	assert.Nil(t, TracerFromContext(ctx))
+}
+
+func TestListerPageSpans(t *testing.T) {
+	tracer := &tracerMock{}
+	ctx := ContextWithTracer(context.Background(), tracer)
+	assert.Equal(t, tracer, TracerFromContext(ctx))
+
+	dataProvider := &DataProviderMock{
+		CountOutputCount: 4,
+		ProductsToRead:   []payloadMock{{ID: 1}, {ID: 2}},
+	}
+	lister := NewLister(ListingSettings{MaxItemsPerRequest: 2, MaxFetchersCount: 1}, dataProvider, NullSleeper)
+
+	itemsCount := 0
+	for item := range lister.Get(ctx, map[string]interface{}{}) {
+		assert.NoError(t, item.Err)
+		itemsCount++
+	}
+	assert.Equal(t, 4, itemsCount)
+
+	if !assert.Len(t, tracer.spans, 2) {
+		return
+	}
+	for i, span := range tracer.spans {
+		assert.Equal(t, SpanListerPage, span.name)
+		assert.True(t, span.ended)
+		assert.Equal(t, map[string]interface{}{
+			AttrFetcher:    0,
+			AttrPagesCount: 1,
+			AttrFirstPage:  i + 1,
+			AttrItemsCount: 2,
+		}, span.attributes)
+	}
+	assert.Equal(t, tracer.spans[1], dataProvider.ReadContextInput.Value(spanCtxKey{}))
+}
+
+type tracingDataProviderMock struct {
+	*DataProviderMock
+	tracer Tracer
+}
+
+func (tdpm tracingDataProviderMock) GetTracer() Tracer {
+	return tdpm.tracer
+}
+
+func TestListerTracerOfDataProvider(t *testing.T) {
+	tracer := &tracerMock{}
+	dataProvider := &DataProviderMock{
+		CountOutputCount: 2,
+		ProductsToRead:   []payloadMock{{ID: 1}, {ID: 2}},
+	}
+	lister := NewLister(
+		ListingSettings{MaxItemsPerRequest: 2, MaxFetchersCount: 1},
+		tracingDataProviderMock{DataProviderMock: dataProvider, tracer: tracer},
+		NullSleeper,
+	)
+
+	for item := range lister.Get(context.Background(), map[string]interface{}{}) {
+		assert.NoError(t, item.Err)
+	}
+
+	if assert.Len(t, tracer.spans, 1) {
+		assert.Equal(t, SpanListerPage, tracer.spans[0].name)
+		assert.Equal(t, tracer, TracerFromContext(dataProvider.CountContextInput))
+		assert.Equal(t, tracer.spans[0], dataProvider.ReadContextInput.Value(spanCtxKey{}))
+	}
+
+	otherTracer := &tracerMock{}
+	for range lister.Get(ContextWithTracer(context.Background(), otherTracer), map[string]interface{}{}) {
+	}
+	assert.Len(t, tracer.spans, 1)
+	assert.Len(t, otherTracer.spans, 1)
+}
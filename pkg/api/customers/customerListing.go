@@ -2,15 +2,18 @@ package customers
 
 import (
 	"context"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
 )
 
 type CustomerListingDataProvider struct {
 	erplyAPI Manager
+	sharedCommon.ListingClient
 }
 
 func NewCustomerListingDataProvider(erplyClient Manager) *CustomerListingDataProvider {
 	return &CustomerListingDataProvider{
-		erplyAPI: erplyClient,
+		erplyAPI:      erplyClient,
+		ListingClient: sharedCommon.NewListingClient(erplyClient),
 	}
 }
 
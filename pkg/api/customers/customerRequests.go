@@ -32,22 +32,49 @@ func (cli *Client) SaveCustomer(ctx context.Context, filters map[string]string)
 	return &res.CustomerImportReports[0], nil
 }
 
+//SaveCustomerWithInput is SaveCustomer with the typed input
+func (cli *Client) SaveCustomerWithInput(ctx context.Context, input SaveCustomerInput) (*CustomerImportReport, error) {
+	filters, err := sharedCommon.EncodeFilters(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli.SaveCustomer(ctx, filters)
+}
+
 // GetCustomers will list customers according to specified filters.
 func (cli *Client) GetCustomers(ctx context.Context, filters map[string]string) ([]Customer, error) {
 	resp, err := cli.SendRequest(ctx, "getCustomers", filters)
 	if err != nil {
 		return nil, err
 	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, sharedCommon.NewFromError("failed to read GetCustomersResponse", err, 0)
+	}
 	var res GetCustomersResponse
-	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+	if err := json.Unmarshal(body, &res); err != nil {
 		return nil, sharedCommon.NewFromError("failed to unmarshal GetCustomersResponse", err, 0)
 	}
 	if !common.IsJSONResponseOK(&res.Status) {
 		return nil, sharedCommon.NewFromResponseStatus(&res.Status)
 	}
+	if err := cli.DecodeExtraFields(body, res.Customers); err != nil {
+		return nil, err
+	}
 	return res.Customers, nil
 }
 
+//GetCustomersWithFilters is GetCustomers with the typed filters
+func (cli *Client) GetCustomersWithFilters(ctx context.Context, filters GetCustomersFilters) ([]Customer, error) {
+	filtersMap, err := sharedCommon.EncodeFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli.GetCustomers(ctx, filtersMap)
+}
+
 // GetCustomersBulk will list customers according to specified filters sending a bulk request to fetch more customers than the default limit
 func (cli *Client) GetCustomersBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (GetCustomersResponseBulk, error) {
 	var customersResponse GetCustomersResponseBulk
@@ -69,19 +96,21 @@ func (cli *Client) GetCustomersBulk(ctx context.Context, bulkFilters []map[strin
 	}
 
 	if err := json.Unmarshal(body, &customersResponse); err != nil {
-		return customersResponse, fmt.Errorf("ERPLY API: failed to unmarshal GetCustomersResponseBulk from '%s': %v", string(body), err)
+		return customersResponse, fmt.Errorf("ERPLY API: failed to unmarshal GetCustomersResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&customersResponse.Status) {
-		return customersResponse, sharedCommon.NewErplyError(customersResponse.Status.ErrorCode.String(), customersResponse.Status.Request+": "+customersResponse.Status.ResponseStatus, customersResponse.Status.ErrorCode)
+		return customersResponse, sharedCommon.NewFromResponseStatus(&customersResponse.Status)
 	}
 
-	for _, supplierBulkItem := range customersResponse.BulkItems {
-		if !common.IsJSONResponseOK(&supplierBulkItem.Status.Status) {
-			return customersResponse, sharedCommon.NewErplyError(supplierBulkItem.Status.ErrorCode.String(), supplierBulkItem.Status.Request+": "+supplierBulkItem.Status.ResponseStatus, customersResponse.Status.ErrorCode)
-		}
+	if err := cli.DecodeBulkExtraFields(body, func(i int) interface{} {
+		return customersResponse.BulkItems[i].Customers
+	}); err != nil {
+		return customersResponse, err
 	}
 
-	return customersResponse, nil
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
+
+	return customersResponse, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 //username and password are required fields here
@@ -174,28 +203,20 @@ func (cli *Client) AddCustomerRewardPointsBulk(ctx context.Context, bulkFilters
 	}
 
 	if err := json.Unmarshal(body, &respBulk); err != nil {
-		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal AddCustomerRewardPointsResponseBulk from '%s': %v", string(body), err)
+		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal AddCustomerRewardPointsResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&respBulk.Status) {
-		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
+		return respBulk, sharedCommon.NewFromResponseStatus(&respBulk.Status)
 	}
 
-	for _, bulkItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkItem.Status.ErrorCode.String(), bulkItem.Status.Request+": "+bulkItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return respBulk, nil
+	return respBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SaveCustomerBulk(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (SaveCustomerResponseBulk, error) {
 	var saveCustomerResponseBulk SaveCustomerResponseBulk
 
-	if len(customerMap) > sharedCommon.MaxBulkRequestsCount {
-		return saveCustomerResponseBulk, fmt.Errorf("cannot save more than %d customers in one request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(customerMap))
 	for _, customer := range customerMap {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -215,24 +236,16 @@ func (cli *Client) SaveCustomerBulk(ctx context.Context, customerMap []map[strin
 	}
 
 	if err := json.Unmarshal(body, &saveCustomerResponseBulk); err != nil {
-		return saveCustomerResponseBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveCustomerResponseBulk from '%s': %v", string(body), err)
+		return saveCustomerResponseBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveCustomerResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&saveCustomerResponseBulk.Status) {
-		return saveCustomerResponseBulk, sharedCommon.NewErplyError(saveCustomerResponseBulk.Status.ErrorCode.String(), saveCustomerResponseBulk.Status.Request+": "+saveCustomerResponseBulk.Status.ResponseStatus, saveCustomerResponseBulk.Status.ErrorCode)
+		return saveCustomerResponseBulk, sharedCommon.NewFromResponseStatus(&saveCustomerResponseBulk.Status)
 	}
 
-	for _, bulkItem := range saveCustomerResponseBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkItem.Status.Status) {
-			return saveCustomerResponseBulk, sharedCommon.NewErplyError(
-				bulkItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkItem.Status),
-				saveCustomerResponseBulk.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return saveCustomerResponseBulk, nil
+	return saveCustomerResponseBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) DeleteCustomer(ctx context.Context, filters map[string]string) error {
@@ -256,10 +269,6 @@ func (cli *Client) DeleteCustomer(ctx context.Context, filters map[string]string
 func (cli *Client) DeleteCustomerBulk(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (DeleteCustomersResponseBulk, error) {
 	var deleteCustomersResponse DeleteCustomersResponseBulk
 
-	if len(customerMap) > sharedCommon.MaxBulkRequestsCount {
-		return deleteCustomersResponse, fmt.Errorf("cannot delete more than %d customers in one request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(customerMap))
 	for _, filter := range customerMap {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -279,26 +288,14 @@ func (cli *Client) DeleteCustomerBulk(ctx context.Context, customerMap []map[str
 	}
 
 	if err := json.Unmarshal(body, &deleteCustomersResponse); err != nil {
-		return deleteCustomersResponse, fmt.Errorf("ERPLY API: failed to unmarshal DeleteCustomersResponseBulk from '%s': %v", string(body), err)
+		return deleteCustomersResponse, fmt.Errorf("ERPLY API: failed to unmarshal DeleteCustomersResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&deleteCustomersResponse.Status) {
-		return deleteCustomersResponse, sharedCommon.NewErplyError(
-			deleteCustomersResponse.Status.ErrorCode.String(),
-			deleteCustomersResponse.Status.Request+": "+deleteCustomersResponse.Status.ResponseStatus,
-			deleteCustomersResponse.Status.ErrorCode,
-		)
+		return deleteCustomersResponse, sharedCommon.NewFromResponseStatus(&deleteCustomersResponse.Status)
 	}
 
-	for _, bulkItem := range deleteCustomersResponse.BulkItems {
-		if !common.IsJSONResponseOK(&bulkItem.Status.Status) {
-			return deleteCustomersResponse, sharedCommon.NewErplyError(
-				bulkItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkItem.Status),
-				deleteCustomersResponse.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return deleteCustomersResponse, nil
+	return deleteCustomersResponse, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
@@ -195,7 +195,7 @@ func TestGetCustomersBulkResponseFailure(t *testing.T) {
 func TestAddCustomerRewardPoints(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "someclient", r.URL.Query().Get("clientCode"))
-		assert.Equal(t, "somesess", r.URL.Query().Get("sessionKey"))
+		assert.Equal(t, "somesess", r.PostFormValue("sessionKey"))
 		assert.Equal(t, "addCustomerRewardPoints", r.URL.Query().Get("request"))
 		assert.Equal(t, "1232131", r.URL.Query().Get("customerID"))
 		assert.Equal(t, "34456", r.URL.Query().Get("invoiceID"))
@@ -500,8 +500,9 @@ func TestDeleteCustomer(t *testing.T) {
 		_, err = w.Write(jsonRaw)
 		assert.NoError(t, err)
 
+		assert.NoError(t, r.ParseForm())
 		reqItems := make(map[string]interface{})
-		for key, vals := range r.URL.Query() {
+		for key, vals := range r.Form {
 			reqItems[key] = vals[0]
 		}
 
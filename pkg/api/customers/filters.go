@@ -0,0 +1,30 @@
+package customers
+
+import (
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"time"
+)
+
+//GetCustomersFilters are the typed filters of getCustomers
+type GetCustomersFilters struct {
+	CustomerID                   int       `json:"customerID"`
+	CustomerIDs                  []int     `json:"customerIDs"`
+	SearchName                   string    `json:"searchName"`
+	SearchRegistryCode           string    `json:"searchRegistryCode"`
+	SearchVatNumber              string    `json:"searchVatNumber"`
+	SearchFromMiddle             bool      `json:"searchFromMiddle"`
+	Code                         string    `json:"code"`
+	Email                        string    `json:"email"`
+	Phone                        string    `json:"phone"`
+	GroupID                      int       `json:"groupID"`
+	ChangedSince                 time.Time `json:"changedSince"`
+	CreatedUnixTimeFrom          time.Time `json:"createdUnixTimeFrom"`
+	GetAddresses                 bool      `json:"getAddresses"`
+	GetContactPersons            bool      `json:"getContactPersons"`
+	GetBalanceInfo               bool      `json:"getBalanceInfo"`
+	GetBalanceWithoutPrepayments bool      `json:"getBalanceWithoutPrepayments"`
+	ResponseMode                 string    `json:"responseMode"`
+	OrderBy                      string    `json:"orderBy"`
+	OrderByDir                   string    `json:"orderByDir"`
+	sharedCommon.Pagination
+}
@@ -0,0 +1,26 @@
+package customers
+
+import (
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//SaveCustomerInput is the typed input of saveCustomer, the customer is updated if CustomerID is set
+type SaveCustomerInput struct {
+	CustomerID        int                         `json:"customerID"`
+	CompanyName       string                      `json:"companyName"`
+	FirstName         string                      `json:"firstName"`
+	LastName          string                      `json:"lastName"`
+	GroupID           int                         `json:"groupID"`
+	Code              string                      `json:"code"`
+	VatNumber         string                      `json:"vatNumber"`
+	Email             string                      `json:"email"`
+	Phone             string                      `json:"phone"`
+	Mobile            string                      `json:"mobile"`
+	Fax               string                      `json:"fax"`
+	Birthday          sharedCommon.Date           `json:"birthday"`
+	Notes             string                      `json:"notes"`
+	PaymentDays       int                         `json:"paymentDays"`
+	CustomerManagerID int                         `json:"customerManagerID"`
+	EmailOptOut       *bool                       `json:"emailOptOut"`
+	Attributes        []sharedCommon.ObjAttribute `json:"attributes,indexed"`
+}
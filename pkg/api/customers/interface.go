@@ -4,8 +4,10 @@ import "context"
 
 type Manager interface {
 	SaveCustomer(ctx context.Context, filters map[string]string) (*CustomerImportReport, error)
+	SaveCustomerWithInput(ctx context.Context, input SaveCustomerInput) (*CustomerImportReport, error)
 	SaveCustomerBulk(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (SaveCustomerResponseBulk, error)
 	GetCustomers(ctx context.Context, filters map[string]string) ([]Customer, error)
+	GetCustomersWithFilters(ctx context.Context, filters GetCustomersFilters) ([]Customer, error)
 	GetCustomersBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (GetCustomersResponseBulk, error)
 	DeleteCustomer(ctx context.Context, filters map[string]string) error
 	DeleteCustomerBulk(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (DeleteCustomersResponseBulk, error)
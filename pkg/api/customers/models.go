@@ -32,7 +32,7 @@ type (
 		BankSWIFT               string                      `json:"bankSWIFT"`
 		PaymentDays             int                         `json:"paymentDays"`
 		Notes                   string                      `json:"notes"`
-		LastModified            int                         `json:"lastModified"`
+		LastModified            sharedCommon.Timestamp      `json:"lastModified"`
 		CustomerType            string                      `json:"customerType"`
 		Address                 string                      `json:"address"`
 		CustomerAddresses       sharedCommon.Addresses      `json:"addresses"`
@@ -55,7 +55,7 @@ type (
 		Gender                  string                      `json:"gender"`
 		GroupName               string                      `json:"groupName"`
 		Mobile                  string                      `json:"mobile"`
-		Birthday                string                      `json:"birthday"`
+		Birthday                sharedCommon.Date           `json:"birthday"`
 		IntegrationCode         string                      `json:"integrationCode"`
 		ColorStatus             string                      `json:"colorStatus"`
 		FactoringContractNumber string                      `json:"factoringContractNumber"`
@@ -82,6 +82,9 @@ type (
 		// Web-shop related fields
 		Username  string `json:"webshopUsername"`
 		LastLogin string `json:"webshopLastLogin"`
+
+		//Extra contains the fields which are not declared above, it's filled only if ClientBuilder.KeepExtraFields is enabled
+		Extra sharedCommon.ExtraFields `json:"-"`
 	}
 
 	SaveCustomerResp struct {
@@ -91,7 +94,7 @@ type (
 
 	SaveCustomerResponseBulkItem struct {
 		Status  sharedCommon.StatusBulk `json:"status"`
-		Records []SaveCustomerResp  `json:"records"`
+		Records []SaveCustomerResp      `json:"records"`
 	}
 
 	SaveCustomerResponseBulk struct {
@@ -225,22 +228,22 @@ type (
 		Attributes      []sharedCommon.ObjAttribute `json:"attributes"`
 
 		// Detail fields
-		VatNumber           string `json:"vatNumber"`
-		Skype               string `json:"skype"`
-		Website             string `json:"website"`
-		BankName            string `json:"bankName"`
-		BankAccountNumber   string `json:"bankAccountNumber"`
-		BankIBAN            string `json:"bankIBAN"`
-		BankSWIFT           string `json:"bankSWIFT"`
-		Birthday            string `json:"birthday"`
-		CompanyID           uint   `json:"companyID"`
-		ParentCompanyName   string `json:"parentCompanyName"`
-		SupplierManagerID   uint   `json:"supplierManagerID"`
-		SupplierManagerName string `json:"supplierManagerName"`
-		PaymentDays         uint   `json:"paymentDays"`
-		Notes               string `json:"notes"`
-		LastModified        string `json:"lastModified"`
-		Added               uint64 `json:"added"`
+		VatNumber           string                 `json:"vatNumber"`
+		Skype               string                 `json:"skype"`
+		Website             string                 `json:"website"`
+		BankName            string                 `json:"bankName"`
+		BankAccountNumber   string                 `json:"bankAccountNumber"`
+		BankIBAN            string                 `json:"bankIBAN"`
+		BankSWIFT           string                 `json:"bankSWIFT"`
+		Birthday            sharedCommon.Date      `json:"birthday"`
+		CompanyID           uint                   `json:"companyID"`
+		ParentCompanyName   string                 `json:"parentCompanyName"`
+		SupplierManagerID   uint                   `json:"supplierManagerID"`
+		SupplierManagerName string                 `json:"supplierManagerName"`
+		PaymentDays         uint                   `json:"paymentDays"`
+		Notes               string                 `json:"notes"`
+		LastModified        sharedCommon.Timestamp `json:"lastModified"`
+		Added               sharedCommon.Timestamp `json:"added"`
 	}
 
 	//SaveSupplierResp
@@ -289,11 +292,11 @@ type (
 	}
 
 	AddCustomerRewardPointsResult struct {
-		TransactionID   int64 `json:"transactionID"`
-		CustomerID      int64 `json:"customerID"`
-		Points          int64 `json:"points"`
-		CreatedUnixTime int64 `json:"createdUnixTime"`
-		ExpiryUnixTime  int64 `json:"expiryUnixTime"`
+		TransactionID   int64                  `json:"transactionID"`
+		CustomerID      int64                  `json:"customerID"`
+		Points          int64                  `json:"points"`
+		CreatedUnixTime sharedCommon.Timestamp `json:"createdUnixTime"`
+		ExpiryUnixTime  sharedCommon.Timestamp `json:"expiryUnixTime"`
 	}
 
 	AddCustomerRewardPointsResponse struct {
@@ -2,15 +2,18 @@ package customers
 
 import (
 	"context"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
 )
 
 type SupplierListingDataProvider struct {
 	erplyAPI Manager
+	sharedCommon.ListingClient
 }
 
 func NewSupplierListingDataProvider(erplyClient Manager) *SupplierListingDataProvider {
 	return &SupplierListingDataProvider{
-		erplyAPI: erplyClient,
+		erplyAPI:      erplyClient,
+		ListingClient: sharedCommon.NewListingClient(erplyClient),
 	}
 }
 
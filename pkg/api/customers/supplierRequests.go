@@ -46,19 +46,15 @@ func (cli *Client) GetSuppliersBulk(ctx context.Context, bulkFilters []map[strin
 	}
 
 	if err := json.Unmarshal(body, &suppliersResp); err != nil {
-		return suppliersResp, fmt.Errorf("ERPLY API: failed to unmarshal GetSuppliersResponseBulk from '%s': %v", string(body), err)
+		return suppliersResp, fmt.Errorf("ERPLY API: failed to unmarshal GetSuppliersResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&suppliersResp.Status) {
-		return suppliersResp, sharedCommon.NewErplyError(suppliersResp.Status.ErrorCode.String(), suppliersResp.Status.Request+": "+suppliersResp.Status.ResponseStatus, suppliersResp.Status.ErrorCode)
+		return suppliersResp, sharedCommon.NewFromResponseStatus(&suppliersResp.Status)
 	}
 
-	for _, supplierBulkItem := range suppliersResp.BulkItems {
-		if !common.IsJSONResponseOK(&supplierBulkItem.Status.Status) {
-			return suppliersResp, sharedCommon.NewErplyError(supplierBulkItem.Status.ErrorCode.String(), supplierBulkItem.Status.Request+": "+supplierBulkItem.Status.ResponseStatus, suppliersResp.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return suppliersResp, nil
+	return suppliersResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SaveSupplier(ctx context.Context, filters map[string]string) (*CustomerImportReport, error) {
@@ -85,10 +81,6 @@ func (cli *Client) SaveSupplier(ctx context.Context, filters map[string]string)
 func (cli *Client) SaveSupplierBulk(ctx context.Context, supplierMap []map[string]interface{}, attrs map[string]string) (SaveSuppliersResponseBulk, error) {
 	var saveSuppliersResponseBulk SaveSuppliersResponseBulk
 
-	if len(supplierMap) > sharedCommon.MaxBulkRequestsCount {
-		return saveSuppliersResponseBulk, fmt.Errorf("cannot save more than %d suppliers in one request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(supplierMap))
 	for _, supplier := range supplierMap {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -108,28 +100,16 @@ func (cli *Client) SaveSupplierBulk(ctx context.Context, supplierMap []map[strin
 	}
 
 	if err := json.Unmarshal(body, &saveSuppliersResponseBulk); err != nil {
-		return saveSuppliersResponseBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveSuppliersResponseBulk from '%s': %v", string(body), err)
+		return saveSuppliersResponseBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveSuppliersResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&saveSuppliersResponseBulk.Status) {
-		return saveSuppliersResponseBulk, sharedCommon.NewErplyError(
-			saveSuppliersResponseBulk.Status.ErrorCode.String(),
-			saveSuppliersResponseBulk.Status.Request+": "+saveSuppliersResponseBulk.Status.ResponseStatus,
-			saveSuppliersResponseBulk.Status.ErrorCode,
-		)
+		return saveSuppliersResponseBulk, sharedCommon.NewFromResponseStatus(&saveSuppliersResponseBulk.Status)
 	}
 
-	for _, supplierBulkItem := range saveSuppliersResponseBulk.BulkItems {
-		if !common.IsJSONResponseOK(&supplierBulkItem.Status.Status) {
-			return saveSuppliersResponseBulk, sharedCommon.NewErplyError(
-				supplierBulkItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", supplierBulkItem.Status),
-				supplierBulkItem.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return saveSuppliersResponseBulk, nil
+	return saveSuppliersResponseBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 // DeleteSupplier https://learn-api.erply.com/requests/deletesupplier/
@@ -151,10 +131,6 @@ func (cli *Client) DeleteSupplier(ctx context.Context, filters map[string]string
 func (cli *Client) DeleteSupplierBulk(ctx context.Context, supplierMap []map[string]interface{}, attrs map[string]string) (DeleteSuppliersResponseBulk, error) {
 	var deleteSupplierResponse DeleteSuppliersResponseBulk
 
-	if len(supplierMap) > sharedCommon.MaxBulkRequestsCount {
-		return deleteSupplierResponse, fmt.Errorf("cannot delete more than %d suppliers in one request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(supplierMap))
 	for _, filter := range supplierMap {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -174,26 +150,14 @@ func (cli *Client) DeleteSupplierBulk(ctx context.Context, supplierMap []map[str
 	}
 
 	if err := json.Unmarshal(body, &deleteSupplierResponse); err != nil {
-		return deleteSupplierResponse, fmt.Errorf("ERPLY API: failed to unmarshal DeleteSuppliersResponseBulk from '%s': %v", string(body), err)
+		return deleteSupplierResponse, fmt.Errorf("ERPLY API: failed to unmarshal DeleteSuppliersResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&deleteSupplierResponse.Status) {
-		return deleteSupplierResponse, sharedCommon.NewErplyError(
-			deleteSupplierResponse.Status.ErrorCode.String(),
-			deleteSupplierResponse.Status.Request+": "+deleteSupplierResponse.Status.ResponseStatus,
-			deleteSupplierResponse.Status.ErrorCode,
-		)
+		return deleteSupplierResponse, sharedCommon.NewFromResponseStatus(&deleteSupplierResponse.Status)
 	}
 
-	for _, supplierBulkItem := range deleteSupplierResponse.BulkItems {
-		if !common.IsJSONResponseOK(&supplierBulkItem.Status.Status) {
-			return deleteSupplierResponse, sharedCommon.NewErplyError(
-				supplierBulkItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", supplierBulkItem.Status),
-				deleteSupplierResponse.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return deleteSupplierResponse, nil
+	return deleteSupplierResponse, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
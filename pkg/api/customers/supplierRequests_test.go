@@ -384,8 +384,9 @@ func TestDeleteSupplier(t *testing.T) {
 		_, err = w.Write(jsonRaw)
 		assert.NoError(t, err)
 
+		assert.NoError(t, r.ParseForm())
 		reqItems := make(map[string]interface{})
-		for key, vals := range r.URL.Query() {
+		for key, vals := range r.Form {
 			reqItems[key] = vals[0]
 		}
 
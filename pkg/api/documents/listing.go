@@ -2,15 +2,18 @@ package documents
 
 import (
 	"context"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
 )
 
 type ListingDataProvider struct {
 	erplyAPI Manager
+	sharedCommon.ListingClient
 }
 
 func NewListingDataProvider(erplyClient Manager) *ListingDataProvider {
 	return &ListingDataProvider{
-		erplyAPI: erplyClient,
+		erplyAPI:      erplyClient,
+		ListingClient: sharedCommon.NewListingClient(erplyClient),
 	}
 }
 
@@ -1,7 +1,8 @@
 package documents
 
 import (
-	"encoding/json"
+	"time"
+
 	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
 )
 
@@ -35,7 +36,7 @@ type ReferencedPurchaseDocument struct {
 	Number    string            `json:"number"`
 	RegNumber string            `json:"regnumber"`
 	Type      PurchaseOrderType `json:"type"`
-	Date      string            `json:"date"`
+	Date      sharedCommon.Date `json:"date"`
 }
 
 type PurchaseDocument struct {
@@ -43,13 +44,13 @@ type PurchaseDocument struct {
 	Type                     PurchaseOrderType            `json:"type"`
 	Status                   DocumentStatus               `json:"status"`
 	CurrencyCode             string                       `json:"currencyCode"`
-	CurrencyRate             json.Number                  `json:"currencyRate"`
+	CurrencyRate             sharedCommon.FlexFloat       `json:"currencyRate"`
 	WarehouseID              int                          `json:"warehouseID"`
 	WarehouseName            string                       `json:"warehouseName"`
 	Number                   string                       `json:"number"`
 	RegNumber                string                       `json:"regnumber"`
-	Date                     string                       `json:"date"`
-	InventoryTransactionDate string                       `json:"inventoryTransactionDate,omitempty"`
+	Date                     sharedCommon.Date            `json:"date"`
+	InventoryTransactionDate sharedCommon.Date            `json:"inventoryTransactionDate,omitempty"`
 	Time                     string                       `json:"time"`
 	SupplierID               int                          `json:"supplierID"`
 	SupplierName             string                       `json:"supplierName"`
@@ -64,7 +65,7 @@ type PurchaseDocument struct {
 	SupplierName2            string                       `json:"supplierName2"`
 	StateID                  int                          `json:"stateID"`
 	PaymentDays              int                          `json:"paymentDays"`
-	Paid                     json.Number                  `json:"paid"`
+	Paid                     sharedCommon.FlexFloat       `json:"paid"`
 	TransactionTypeID        int                          `json:"transactionTypeID"`
 	TransportTypeID          int                          `json:"transportTypeID"`
 	DeliveryTermsID          int                          `json:"deliveryTermsID"`
@@ -81,35 +82,40 @@ type PurchaseDocument struct {
 	NetTotalsByTaxRate       []VatRate                    `json:"netTotalsByTaxRate"`
 	VatTotalsByTaxRate       []VatRate                    `json:"vatTotalsByTaxRate"`
 	InvoiceLink              string                       `json:"invoiceLink"`
-	ShipDate                 string                       `json:"shipDate"`
+	ShipDate                 sharedCommon.Date            `json:"shipDate"`
 	Cost                     float64                      `json:"cost"`
-	NetTotalForAccounting    json.Number                  `json:"netTotalForAccounting"`
-	TotalForAccounting       json.Number                  `json:"totalForAccounting"`
+	NetTotalForAccounting    sharedCommon.FlexFloat       `json:"netTotalForAccounting"`
+	TotalForAccounting       sharedCommon.FlexFloat       `json:"totalForAccounting"`
 	BaseToDocuments          []ReferencedPurchaseDocument `json:"baseToDocuments"`
 	BaseDocuments            []ReferencedPurchaseDocument `json:"baseDocuments"`
-	LastModified             int64                        `json:"lastModified"`
+	LastModified             sharedCommon.Timestamp       `json:"lastModified"`
 	Rows                     []PurchaseDocumentRow        `json:"rows"`
 	Attributes               []sharedCommon.ObjAttribute  `json:"attributes"`
 }
 
 type PurchaseDocumentRow struct {
-	ProductID        int         `json:"productID"`
-	ServiceID        int         `json:"serviceID"`
-	ItemName         string      `json:"itemName"`
-	Code             string      `json:"code"`
-	Code2            string      `json:"code2"`
-	VatrateID        int         `json:"vatrateID"`
-	Amount           json.Number `json:"amount"`
-	Price            json.Number `json:"price"`
-	Discount         json.Number `json:"discount"`
-	DeliveryDate     string      `json:"deliveryDate"`
-	UnitCost         json.Number `json:"unitCost"`
-	CostTotal        float64     `json:"costTotal"`
-	PackageID        int         `json:"packageID"`
-	AmountOfPackages json.Number `json:"amountOfPackages"`
-	AmountInPackage  json.Number `json:"amountInPackage"`
-	PackageType      string      `json:"packageType"`
-	PackageTypeID    int         `json:"packageTypeID"`
+	ProductID        int                    `json:"productID"`
+	ServiceID        int                    `json:"serviceID"`
+	ItemName         string                 `json:"itemName"`
+	Code             string                 `json:"code"`
+	Code2            string                 `json:"code2"`
+	VatrateID        int                    `json:"vatrateID"`
+	Amount           sharedCommon.FlexFloat `json:"amount"`
+	Price            sharedCommon.FlexFloat `json:"price"`
+	Discount         sharedCommon.FlexFloat `json:"discount"`
+	DeliveryDate     sharedCommon.Date      `json:"deliveryDate"`
+	UnitCost         sharedCommon.FlexFloat `json:"unitCost"`
+	CostTotal        float64                `json:"costTotal"`
+	PackageID        int                    `json:"packageID"`
+	AmountOfPackages sharedCommon.FlexFloat `json:"amountOfPackages"`
+	AmountInPackage  sharedCommon.FlexFloat `json:"amountInPackage"`
+	PackageType      string                 `json:"packageType"`
+	PackageTypeID    int                    `json:"packageTypeID"`
+}
+
+//DateTime gives the date and the time of the document in the location of the account, nil means UTC
+func (pd PurchaseDocument) DateTime(loc *time.Location) (time.Time, error) {
+	return pd.Date.At(pd.Time, loc)
 }
 
 type GetPurchaseDocumentBulkItem struct {
@@ -22,7 +22,7 @@ func (cli *Client) GetPurchaseDocuments(ctx context.Context, filters map[string]
 	}
 
 	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, fmt.Errorf("ERPLY API: failed to unmarshal GetPurchaseDocumentsResponse from '%s': %v", string(body), err)
+		return nil, fmt.Errorf("ERPLY API: failed to unmarshal GetPurchaseDocumentsResponse from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&res.Status) {
 		return nil, sharedCommon.NewFromResponseStatus(&res.Status)
@@ -51,17 +51,13 @@ func (cli *Client) GetPurchaseDocumentsBulk(ctx context.Context, bulkFilters []m
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetPurchaseDocumentResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetPurchaseDocumentResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(bulkItem.Status.ErrorCode.String(), bulkItem.Status.Request+": "+bulkItem.Status.ResponseStatus, bulkResp.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
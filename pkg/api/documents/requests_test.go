@@ -23,11 +23,11 @@ func TestGetPurchaseDocumentsBulk(t *testing.T) {
 					PurchaseDocuments: []PurchaseDocument{
 						{
 							ID:           123,
-							CurrencyRate: json.Number("1"),
+							CurrencyRate: 1,
 						},
 						{
 							ID:           124,
-							CurrencyRate: json.Number("2"),
+							CurrencyRate: 2,
 						},
 					},
 				},
@@ -36,7 +36,7 @@ func TestGetPurchaseDocumentsBulk(t *testing.T) {
 					PurchaseDocuments: []PurchaseDocument{
 						{
 							ID:           125,
-							CurrencyRate: json.Number("3"),
+							CurrencyRate: 3,
 						},
 					},
 				},
@@ -82,18 +82,12 @@ func TestGetPurchaseDocumentsBulk(t *testing.T) {
 
 	assert.Equal(t, []PurchaseDocument{
 		{
-			ID:                    123,
-			CurrencyRate:          "1",
-			Paid:                  "0",
-			NetTotalForAccounting: "0",
-			TotalForAccounting:    "0",
+			ID:           123,
+			CurrencyRate: 1,
 		},
 		{
-			ID:                    124,
-			CurrencyRate:          "2",
-			Paid:                  "0",
-			NetTotalForAccounting: "0",
-			TotalForAccounting:    "0",
+			ID:           124,
+			CurrencyRate: 2,
 		},
 	}, bulkResp.BulkItems[0].PurchaseDocuments)
 
@@ -101,11 +95,8 @@ func TestGetPurchaseDocumentsBulk(t *testing.T) {
 
 	assert.Equal(t, []PurchaseDocument{
 		{
-			ID:                    125,
-			CurrencyRate:          "3",
-			Paid:                  "0",
-			NetTotalForAccounting: "0",
-			TotalForAccounting:    "0",
+			ID:           125,
+			CurrencyRate: 3,
 		},
 	}, bulkResp.BulkItems[1].PurchaseDocuments)
 	assert.Equal(t, expectedStatus, bulkResp.BulkItems[1].Status)
@@ -119,18 +110,10 @@ func TestGetPurchaseDocuments(t *testing.T) {
 			Status: sharedCommon.Status{ResponseStatus: "ok"},
 			PurchaseDocuments: []PurchaseDocument{
 				{
-					ID:                    123,
-					CurrencyRate:          "0",
-					Paid:                  "0",
-					NetTotalForAccounting: "0",
-					TotalForAccounting:    "0",
+					ID: 123,
 				},
 				{
-					ID:                    124,
-					CurrencyRate:          "0",
-					Paid:                  "0",
-					NetTotalForAccounting: "0",
-					TotalForAccounting:    "0",
+					ID: 124,
 				},
 			},
 		}
@@ -159,18 +142,10 @@ func TestGetPurchaseDocuments(t *testing.T) {
 
 	assert.Equal(t, []PurchaseDocument{
 		{
-			ID:                    123,
-			CurrencyRate:          "0",
-			Paid:                  "0",
-			NetTotalForAccounting: "0",
-			TotalForAccounting:    "0",
+			ID: 123,
 		},
 		{
-			ID:                    124,
-			CurrencyRate:          "0",
-			Paid:                  "0",
-			NetTotalForAccounting: "0",
-			TotalForAccounting:    "0",
+			ID: 124,
 		},
 	}, actualDocuments)
 }
@@ -0,0 +1,340 @@
+//Package fakeapi provides an in-memory stand-in for the ERPLY API which runs on httptest, point the clients
+//to its URL in integration tests
+package fakeapi
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+)
+
+//Server serves the wrapped ERPLY requests of products, customers, addresses, warehouses, sales documents, payments,
+//price lists and VAT rates from an in-memory store. It supports bulk requests, pagination with recordsOnPage and pageNo,
+//recordsTotal, filtering by IDs and record fields, changedSince, verifyUser and getSessionKeyInfo. It's safe for concurrent use
+type Server struct {
+	*httptest.Server
+	//ClientCode is the only client code accepted by the server
+	ClientCode string
+	//SessionLength is the length of the sessions created by verifyUser in seconds
+	SessionLength int
+
+	lock          sync.Mutex
+	store         *Store
+	users         map[string]string
+	sessions      map[string]*session
+	requestCounts map[string]int
+	clock         func() time.Time
+}
+
+//NewServer starts a server which accepts the requests of the client code
+func NewServer(clientCode string) *Server {
+	s := &Server{
+		ClientCode:    clientCode,
+		SessionLength: 3600,
+		users:         map[string]string{},
+		sessions:      map[string]*session{},
+		requestCounts: map[string]int{},
+		clock:         time.Now,
+	}
+	s.store = NewStore()
+	s.store.clock = func() time.Time {
+		return s.clock()
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+//Insert adds records to the collection, the records can be the models of the SDK like products.Product or maps.
+//The records without an ID get the next free one, the records with an existing ID replace the stored ones
+func (s *Server) Insert(collectionName string, records ...interface{}) ([]int, error) {
+	return s.store.Insert(collectionName, records...)
+}
+
+//Records gives the stored records of the collection
+func (s *Server) Records(collectionName string) []map[string]interface{} {
+	return s.store.Records(collectionName)
+}
+
+//AddUser allows verifyUser with the user name and password
+func (s *Server) AddUser(userName, password string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.users[userName] = password
+}
+
+//AddSession makes the server accept the session key
+func (s *Server) AddSession(sessionKey string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.sessions[sessionKey] = s.newSession()
+}
+
+//ExpireSessions makes the server reject all existing session keys with APISessionExpired
+func (s *Server) ExpireSessions() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, sess := range s.sessions {
+		sess.expired = true
+	}
+}
+
+//RequestCounts gives how many times each request was served, the sub-requests of bulk requests are counted one by one
+func (s *Server) RequestCounts() map[string]int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	counts := make(map[string]int, len(s.requestCounts))
+	for name, count := range s.requestCounts {
+		counts[name] = count
+	}
+
+	return counts
+}
+
+type session struct {
+	created time.Time
+	expires time.Time
+	expired bool
+}
+
+type response struct {
+	Status  status        `json:"status"`
+	Records []interface{} `json:"records"`
+}
+
+type bulkResponse struct {
+	Status   status             `json:"status"`
+	Requests []bulkResponseItem `json:"requests"`
+}
+
+type bulkResponseItem struct {
+	Status  bulkStatus    `json:"status"`
+	Records []interface{} `json:"records"`
+}
+
+type status struct {
+	Request           string                `json:"request,omitempty"`
+	RequestUnixTime   int64                 `json:"requestUnixTime"`
+	ResponseStatus    string                `json:"responseStatus"`
+	ErrorCode         sharedCommon.ApiError `json:"errorCode"`
+	ErrorField        string                `json:"errorField,omitempty"`
+	GenerationTime    float64               `json:"generationTime"`
+	RecordsTotal      int                   `json:"recordsTotal"`
+	RecordsInResponse int                   `json:"recordsInResponse"`
+}
+
+type bulkStatus struct {
+	RequestName string `json:"requestName"`
+	RequestID   string `json:"requestID,omitempty"`
+	status
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	params := make(map[string]string, len(r.Form))
+	for key := range r.Form {
+		params[key] = r.Form.Get(key)
+	}
+
+	var body interface{}
+	if bulkRequests, ok := params["requests"]; ok {
+		delete(params, "requests")
+		body = s.handleBulk(params, bulkRequests)
+	} else {
+		body = s.handle(params)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) handleBulk(params map[string]string, bulkRequests string) bulkResponse {
+	started := s.clock()
+	resp := bulkResponse{Requests: []bulkResponseItem{}}
+
+	var subRequests []map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(bulkRequests)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&subRequests); err != nil {
+		resp.Status = s.newStatus("", started, sharedCommon.MalformedRequest, "requests", 0, 0)
+		return resp
+	}
+	if len(subRequests) > sharedCommon.MaxBulkRequestsCount {
+		resp.Status = s.newStatus("", started, sharedCommon.TooManyBulkSubRequests, "requests", 0, 0)
+		return resp
+	}
+	if apiErr := s.checkAuth(params); apiErr != 0 {
+		resp.Status = s.newStatus("", started, apiErr, "", 0, 0)
+		return resp
+	}
+
+	resp.Status = s.newStatus("", started, 0, "", 0, 0)
+	for _, subRequest := range subRequests {
+		subParams := make(map[string]string, len(params)+len(subRequest))
+		for key, value := range params {
+			subParams[key] = value
+		}
+		for key, value := range subRequest {
+			subParams[key] = fmt.Sprint(value)
+		}
+		subParams["request"] = subParams["requestName"]
+
+		subResp := s.handle(subParams)
+		resp.Requests = append(resp.Requests, bulkResponseItem{
+			Status: bulkStatus{
+				RequestName: subParams["requestName"],
+				RequestID:   subParams["requestID"],
+				status:      subResp.Status,
+			},
+			Records: subResp.Records,
+		})
+	}
+
+	return resp
+}
+
+func (s *Server) handle(params map[string]string) response {
+	started := s.clock()
+	requestName := params["request"]
+
+	s.lock.Lock()
+	s.requestCounts[requestName]++
+
+	if requestName == "verifyUser" {
+		defer s.lock.Unlock()
+		if params["clientCode"] != s.ClientCode {
+			return response{Status: s.newStatus(requestName, started, sharedCommon.AccountNotFound, "clientCode", 0, 0), Records: []interface{}{}}
+		}
+		return s.verifyUser(requestName, started, params)
+	}
+
+	apiErr := s.checkAuthLocked(params)
+	if apiErr == 0 && requestName == "getSessionKeyInfo" {
+		defer s.lock.Unlock()
+		return s.getSessionKeyInfo(requestName, started, params)
+	}
+	s.lock.Unlock()
+	if apiErr != 0 {
+		return response{Status: s.newStatus(requestName, started, apiErr, "", 0, 0), Records: []interface{}{}}
+	}
+
+	if !s.store.Handles(requestName) {
+		return response{Status: s.newStatus(requestName, started, sharedCommon.UnknownApi, "request", 0, 0), Records: []interface{}{}}
+	}
+
+	result := s.store.Handle(requestName, params)
+
+	return response{
+		Status:  s.newStatus(requestName, started, result.ErrorCode, result.ErrorField, result.Total, len(result.Records)),
+		Records: result.Records,
+	}
+}
+
+func (s *Server) checkAuth(params map[string]string) sharedCommon.ApiError {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.checkAuthLocked(params)
+}
+
+func (s *Server) checkAuthLocked(params map[string]string) sharedCommon.ApiError {
+	if params["clientCode"] != s.ClientCode {
+		return sharedCommon.AccountNotFound
+	}
+
+	sess, known := s.sessions[params["sessionKey"]]
+	switch {
+	case params["sessionKey"] == "":
+		return sharedCommon.MissingAuth
+	case !known:
+		return sharedCommon.InvalidSession
+	case sess.expired:
+		return sharedCommon.APISessionExpired
+	}
+
+	return 0
+}
+
+//verifyUser creates a new session for a known user, it's called with the lock held
+func (s *Server) verifyUser(requestName string, started time.Time, params map[string]string) response {
+	password, ok := s.users[params["username"]]
+	if !ok || password != params["password"] {
+		return response{Status: s.newStatus(requestName, started, sharedCommon.LoginFailed, "", 0, 0), Records: []interface{}{}}
+	}
+
+	sessionKeyBytes := make([]byte, 16)
+	if _, err := rand.Read(sessionKeyBytes); err != nil {
+		return response{Status: s.newStatus(requestName, started, sharedCommon.ServerMaintenance, "", 0, 0), Records: []interface{}{}}
+	}
+	sessionKey := hex.EncodeToString(sessionKeyBytes)
+	s.sessions[sessionKey] = s.newSession()
+
+	return response{
+		Status: s.newStatus(requestName, started, 0, "", 1, 1),
+		Records: []interface{}{
+			map[string]interface{}{
+				"userID":        "1",
+				"userName":      params["username"],
+				"sessionKey":    sessionKey,
+				"sessionLength": s.SessionLength,
+			},
+		},
+	}
+}
+
+//getSessionKeyInfo gives the creation and expiry times of a valid session, it's called with the lock held
+func (s *Server) getSessionKeyInfo(requestName string, started time.Time, params map[string]string) response {
+	sess := s.sessions[params["sessionKey"]]
+
+	return response{
+		Status: s.newStatus(requestName, started, 0, "", 1, 1),
+		Records: []interface{}{
+			map[string]interface{}{
+				"creationUnixTime": strconv.FormatInt(sess.created.Unix(), 10),
+				"expireUnixTime":   strconv.FormatInt(sess.expires.Unix(), 10),
+			},
+		},
+	}
+}
+
+func (s *Server) newSession() *session {
+	created := s.clock()
+
+	return &session{created: created, expires: created.Add(time.Duration(s.SessionLength) * time.Second)}
+}
+
+func (s *Server) newStatus(requestName string, started time.Time, apiErr sharedCommon.ApiError, errorField string, total, inResponse int) status {
+	responseStatus := "ok"
+	if apiErr != 0 {
+		responseStatus = "error"
+	}
+
+	return status{
+		Request:           requestName,
+		RequestUnixTime:   started.Unix(),
+		ResponseStatus:    responseStatus,
+		ErrorCode:         apiErr,
+		ErrorField:        errorField,
+		GenerationTime:    s.clock().Sub(started).Seconds(),
+		RecordsTotal:      total,
+		RecordsInResponse: inResponse,
+	}
+}
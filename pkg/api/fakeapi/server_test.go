@@ -0,0 +1,179 @@
+package fakeapi
+
+import (
+	"context"
+	"errors"
+	"github.com/erply/api-go-wrapper/pkg/api"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/products"
+	"github.com/erply/api-go-wrapper/pkg/api/sales"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type productsResponse products.GetProductsResponse
+
+func (pr *productsResponse) GetStatus() *sharedCommon.Status {
+	return &pr.Status
+}
+
+func newTestClient(t *testing.T, srv *Server) *api.Client {
+	srv.AddSession("somesess")
+	cli, err := api.NewClientWithURL("somesess", "123", "", srv.URL, nil, nil)
+	assert.NoError(t, err)
+
+	return cli
+}
+
+func TestProductsPaginationAndFilters(t *testing.T) {
+	srv := NewServer("123")
+	defer srv.Close()
+	cli := newTestClient(t, srv)
+
+	for i := 0; i < 25; i++ {
+		_, err := srv.Insert(Products, map[string]interface{}{"name": "product", "code": "code"})
+		assert.NoError(t, err)
+	}
+	ids, err := srv.Insert(Products, map[string]interface{}{"name": "special", "code": "special"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{26}, ids)
+
+	resp := &productsResponse{}
+	err = cli.Call(context.Background(), "getProducts", map[string]string{"recordsOnPage": "10", "pageNo": "3"}, resp)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 26, resp.Status.RecordsTotal)
+		assert.Equal(t, 6, resp.Status.RecordsInResponse)
+		assert.Equal(t, 21, resp.Products[0].ProductID)
+	}
+
+	prods, err := cli.ProductManager.GetProducts(context.Background(), map[string]string{"code": "special"})
+	if assert.NoError(t, err) && assert.Len(t, prods, 1) {
+		assert.Equal(t, 26, prods[0].ProductID)
+	}
+
+	prods, err = cli.ProductManager.GetProducts(context.Background(), map[string]string{"productIDs": "2,4"})
+	if assert.NoError(t, err) && assert.Len(t, prods, 2) {
+		assert.Equal(t, []int{2, 4}, []int{prods[0].ProductID, prods[1].ProductID})
+	}
+}
+
+func TestSaveAndDelete(t *testing.T) {
+	srv := NewServer("123")
+	defer srv.Close()
+	cli := newTestClient(t, srv)
+	ctx := context.Background()
+
+	saveResult, err := cli.ProductManager.SaveProduct(ctx, map[string]string{"name": "new", "groupID": "3", "unknownParam": "x"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, saveResult.ProductID)
+
+	_, err = cli.ProductManager.SaveProduct(ctx, map[string]string{"productID": "1", "code": "abc"})
+	assert.NoError(t, err)
+
+	prods, err := cli.ProductManager.GetProducts(ctx, map[string]string{"productID": "1"})
+	if assert.NoError(t, err) && assert.Len(t, prods, 1) {
+		assert.Equal(t, "new", prods[0].Name)
+		assert.Equal(t, "abc", prods[0].Code)
+		assert.Equal(t, uint(3), prods[0].GroupID)
+		assert.False(t, prods[0].LastModified.IsZero())
+	}
+	assert.NotContains(t, srv.Records(Products)[0], "unknownParam")
+
+	_, err = cli.ProductManager.SaveProduct(ctx, map[string]string{"productID": "100"})
+	assert.True(t, errors.Is(err, sharedCommon.ErrNotFound))
+
+	assert.NoError(t, cli.ProductManager.DeleteProduct(ctx, map[string]string{"productID": "1"}))
+	assert.Empty(t, srv.Records(Products))
+
+	customerReport, err := cli.CustomerManager.SaveCustomer(ctx, map[string]string{"firstName": "John"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, customerReport.CustomerID)
+	}
+
+	reports, err := cli.SalesManager.SaveSalesDocument(ctx, map[string]string{
+		"type":       "INVWAYBILL",
+		"productID1": "5",
+		"amount1":    "2",
+		"productID2": "6",
+		"amount2":    "1.5",
+	})
+	if assert.NoError(t, err) && assert.Len(t, reports, 1) {
+		assert.Equal(t, 1, reports[0].InvoiceID)
+	}
+
+	docs, err := cli.SalesManager.GetSalesDocuments(ctx, map[string]string{"id": "1"})
+	if assert.NoError(t, err) && assert.Len(t, docs, 1) && assert.Len(t, docs[0].InvoiceRows, 2) {
+		assert.Equal(t, 6, int(docs[0].InvoiceRows[1].ProductID))
+		assert.Equal(t, 1.5, float64(docs[0].InvoiceRows[1].Amount))
+	}
+}
+
+func TestBulkAndLister(t *testing.T) {
+	srv := NewServer("123")
+	defer srv.Close()
+	cli := newTestClient(t, srv)
+
+	for i := 0; i < 250; i++ {
+		_, err := srv.Insert(Products, map[string]interface{}{"name": "product"})
+		assert.NoError(t, err)
+	}
+
+	bulkResp, err := cli.ProductManager.GetProductsBulk(
+		context.Background(),
+		[]map[string]interface{}{
+			{"recordsOnPage": 100, "pageNo": 1},
+			{"recordsOnPage": 100, "pageNo": 3},
+			{"productID": 1000},
+		},
+		map[string]string{},
+	)
+	if assert.NoError(t, err) && assert.Len(t, bulkResp.BulkItems, 3) {
+		assert.Len(t, bulkResp.BulkItems[0].Products, 100)
+		assert.Len(t, bulkResp.BulkItems[1].Products, 50)
+		assert.Equal(t, 250, bulkResp.BulkItems[1].Status.RecordsTotal)
+		assert.Len(t, bulkResp.BulkItems[2].Products, 0)
+	}
+
+	lister := sharedCommon.NewLister(
+		sharedCommon.ListingSettings{MaxItemsPerRequest: 200, MaxFetchersCount: 2},
+		products.NewListingDataProvider(cli.ProductManager),
+		func(sleepTime time.Duration) {},
+	)
+	ids := map[int]bool{}
+	for item := range lister.Get(context.Background(), map[string]interface{}{}) {
+		if assert.NoError(t, item.Err) {
+			ids[item.Payload.(products.Product).ProductID] = true
+		}
+	}
+	assert.Len(t, ids, 250)
+}
+
+func TestSessions(t *testing.T) {
+	srv := NewServer("123")
+	defer srv.Close()
+	srv.AddUser("user", "pass")
+	_, err := srv.Insert(VatRates, sales.VatRate{Name: "standard", Rate: "20"})
+	assert.NoError(t, err)
+
+	cli, err := api.NewClientWithURL("unknown", "123", "", srv.URL, nil, nil)
+	assert.NoError(t, err)
+	_, err = cli.SalesManager.GetVatRates(context.Background(), map[string]string{})
+	assert.True(t, errors.Is(err, sharedCommon.ErrAuth))
+
+	builtCli := api.ClientBuilder{ClientCode: "123", UserName: "user", Password: "pass", URL: srv.URL}.Build()
+	vatRates, err := builtCli.SalesManager.GetVatRates(context.Background(), map[string]string{})
+	if assert.NoError(t, err) && assert.Len(t, vatRates, 1) {
+		assert.Equal(t, "1", vatRates[0].ID)
+	}
+
+	srv.ExpireSessions()
+	_, err = builtCli.SalesManager.GetVatRates(context.Background(), map[string]string{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, srv.RequestCounts()["verifyUser"])
+	assert.Equal(t, 4, srv.RequestCounts()["getVatRates"])
+
+	wrongCli := api.ClientBuilder{ClientCode: "123", UserName: "user", Password: "wrong", URL: srv.URL}.Build()
+	_, err = wrongCli.SalesManager.GetVatRates(context.Background(), map[string]string{})
+	assert.Error(t, err)
+}
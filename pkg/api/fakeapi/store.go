@@ -0,0 +1,710 @@
+package fakeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/customers"
+	"github.com/erply/api-go-wrapper/pkg/api/prices"
+	"github.com/erply/api-go-wrapper/pkg/api/products"
+	"github.com/erply/api-go-wrapper/pkg/api/sales"
+	"github.com/erply/api-go-wrapper/pkg/api/warehouse"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//The names of the collections of the in-memory store
+const (
+	Products           = "products"
+	Customers          = "customers"
+	Addresses          = "addresses"
+	Warehouses         = "warehouses"
+	SalesDocuments     = "salesDocuments"
+	Payments           = "payments"
+	SupplierPriceLists = "supplierPriceLists"
+	PriceLists         = "priceLists"
+	VatRates           = "vatRates"
+)
+
+//collectionSettings describe how the requests of a collection are served
+type collectionSettings struct {
+	name string
+	//getRequest, saveRequest and deleteRequest are the API requests of the collection, empty if not supported
+	getRequest    string
+	saveRequest   string
+	deleteRequest string
+	//idParam is the filter of getRequest and the parameter of saveRequest which holds the ID
+	idParam string
+	//deleteIDParam is the parameter of deleteRequest which holds the ID, idParam is used if it's empty
+	deleteIDParam string
+	//recordIDFields are the fields of the records which hold the ID
+	recordIDFields []string
+	//model is the type of the records, the saved parameters are converted to the types of its fields,
+	//if it's nil all parameters are stored as strings
+	model interface{}
+	//saveResult gives the record of the saveRequest response
+	saveResult func(id int) map[string]interface{}
+	//saveIDField is the field of the saveResult record which holds the ID
+	saveIDField string
+}
+
+func (cs collectionSettings) getDeleteIDParam() string {
+	if cs.deleteIDParam != "" {
+		return cs.deleteIDParam
+	}
+
+	return cs.idParam
+}
+
+var collectionsSettings = []collectionSettings{
+	{
+		name:           Products,
+		getRequest:     "getProducts",
+		saveRequest:    "saveProduct",
+		deleteRequest:  "deleteProduct",
+		idParam:        "productID",
+		recordIDFields: []string{"productID"},
+		model:          products.Product{},
+		saveResult: func(id int) map[string]interface{} {
+			return map[string]interface{}{"productID": id}
+		},
+		saveIDField: "productID",
+	},
+	{
+		name:           Customers,
+		getRequest:     "getCustomers",
+		saveRequest:    "saveCustomer",
+		deleteRequest:  "deleteCustomer",
+		idParam:        "customerID",
+		recordIDFields: []string{"id", "customerID"},
+		model:          customers.Customer{},
+		saveResult: func(id int) map[string]interface{} {
+			return map[string]interface{}{"customerID": id, "alreadyExists": false}
+		},
+		saveIDField: "customerID",
+	},
+	{
+		name:           Addresses,
+		getRequest:     "getAddresses",
+		saveRequest:    "saveAddress",
+		deleteRequest:  "deleteAddress",
+		idParam:        "addressID",
+		recordIDFields: []string{"addressID"},
+		model:          sharedCommon.Address{},
+		saveResult: func(id int) map[string]interface{} {
+			return map[string]interface{}{"addressID": id}
+		},
+		saveIDField: "addressID",
+	},
+	{
+		name:           Warehouses,
+		getRequest:     "getWarehouses",
+		saveRequest:    "saveWarehouse",
+		idParam:        "warehouseID",
+		recordIDFields: []string{"warehouseID"},
+		model:          warehouse.Warehouse{},
+		saveResult: func(id int) map[string]interface{} {
+			return map[string]interface{}{"warehouseID": id}
+		},
+		saveIDField: "warehouseID",
+	},
+	{
+		name:           SalesDocuments,
+		getRequest:     "getSalesDocuments",
+		saveRequest:    "saveSalesDocument",
+		deleteRequest:  "deleteSalesDocument",
+		idParam:        "id",
+		deleteIDParam:  "documentID",
+		recordIDFields: []string{"id"},
+		model:          sales.SaleDocument{},
+		saveResult: func(id int) map[string]interface{} {
+			return map[string]interface{}{"invoiceID": id, "invoiceNo": strconv.Itoa(id)}
+		},
+		saveIDField: "invoiceID",
+	},
+	{
+		name:           Payments,
+		getRequest:     "getPayments",
+		saveRequest:    "savePayment",
+		idParam:        "paymentID",
+		recordIDFields: []string{"paymentID"},
+		model:          sales.PaymentInfo{},
+		saveResult: func(id int) map[string]interface{} {
+			return map[string]interface{}{"paymentID": id}
+		},
+		saveIDField: "paymentID",
+	},
+	{
+		name:           SupplierPriceLists,
+		getRequest:     "getSupplierPriceLists",
+		saveRequest:    "saveSupplierPriceList",
+		idParam:        "supplierPriceListID",
+		recordIDFields: []string{"supplierPriceListID"},
+		model:          prices.PriceList{},
+		saveResult: func(id int) map[string]interface{} {
+			return map[string]interface{}{"supplierPriceListID": id}
+		},
+		saveIDField: "supplierPriceListID",
+	},
+	{
+		name:           PriceLists,
+		getRequest:     "getPriceLists",
+		saveRequest:    "savePriceList",
+		idParam:        "pricelistID",
+		recordIDFields: []string{"pricelistID"},
+		saveResult: func(id int) map[string]interface{} {
+			return map[string]interface{}{"pricelistID": id, "itemsNotAddedToPriceList": []interface{}{}}
+		},
+		saveIDField: "pricelistID",
+	},
+	{
+		name:           VatRates,
+		getRequest:     "getVatRates",
+		saveRequest:    "saveVatRate",
+		idParam:        "id",
+		recordIDFields: []string{"id"},
+		model:          sales.VatRate{},
+		saveResult: func(id int) map[string]interface{} {
+			return map[string]interface{}{"vatRateID": id}
+		},
+		saveIDField: "vatRateID",
+	},
+}
+
+//Result is the outcome of a request served by the Store
+type Result struct {
+	Records    []interface{}
+	Total      int
+	ErrorCode  sharedCommon.ApiError
+	ErrorField string
+}
+
+//Store keeps the records of the collections in memory and serves their get, save and delete requests,
+//it's used by the Server and can serve the requests without HTTP as well. It's safe for concurrent use
+type Store struct {
+	lock        sync.Mutex
+	collections map[string]*collection
+	requests    map[string]func(params map[string]string) Result
+	clock       func() time.Time
+}
+
+//NewStore creates an empty store
+func NewStore() *Store {
+	s := &Store{
+		collections: map[string]*collection{},
+		requests:    map[string]func(params map[string]string) Result{},
+		clock:       time.Now,
+	}
+
+	for _, settings := range collectionsSettings {
+		c := newCollection(settings)
+		s.collections[settings.name] = c
+
+		if settings.getRequest != "" {
+			s.requests[settings.getRequest] = func(params map[string]string) Result {
+				page, total, apiErr := c.query(params)
+				records := make([]interface{}, 0, len(page))
+				for _, record := range page {
+					records = append(records, record)
+				}
+				return Result{Records: records, Total: total, ErrorCode: apiErr}
+			}
+		}
+		if settings.saveRequest != "" {
+			s.requests[settings.saveRequest] = func(params map[string]string) Result {
+				id, apiErr := c.save(params, s.clock().Unix())
+				if apiErr != 0 {
+					return Result{ErrorCode: apiErr, ErrorField: c.settings.idParam}
+				}
+				return Result{Records: []interface{}{c.settings.saveResult(id)}, Total: 1}
+			}
+		}
+		if settings.deleteRequest != "" {
+			s.requests[settings.deleteRequest] = func(params map[string]string) Result {
+				idParam := c.settings.getDeleteIDParam()
+				id, err := strconv.Atoi(params[idParam])
+				if err != nil || id == 0 {
+					return Result{ErrorCode: sharedCommon.RequiredParamMissing, ErrorField: idParam}
+				}
+				if !c.delete(id) {
+					return Result{ErrorCode: sharedCommon.InvalidClassifierID, ErrorField: idParam}
+				}
+				return Result{}
+			}
+		}
+	}
+
+	return s
+}
+
+//Handles tells if the store serves the request
+func (s *Store) Handles(requestName string) bool {
+	_, ok := s.requests[requestName]
+
+	return ok
+}
+
+//SaveIDField gives the field of the save request response which holds the ID of the saved record,
+//it's empty if the request isn't a save request of the store
+func (s *Store) SaveIDField(requestName string) string {
+	for _, c := range s.collections {
+		if c.settings.saveRequest != "" && c.settings.saveRequest == requestName {
+			return c.settings.saveIDField
+		}
+	}
+
+	return ""
+}
+
+//Handle serves the request with the given parameters, the unknown requests get the UnknownApi error
+func (s *Store) Handle(requestName string, params map[string]string) Result {
+	handler, ok := s.requests[requestName]
+	if !ok {
+		return Result{ErrorCode: sharedCommon.UnknownApi, ErrorField: "request"}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	result := handler(params)
+	if result.Records == nil {
+		result.Records = []interface{}{}
+	}
+
+	return result
+}
+
+//Insert adds records to the collection, the records can be the models of the SDK like products.Product or maps.
+//The records without an ID get the next free one, the records with an existing ID replace the stored ones
+func (s *Store) Insert(collectionName string, records ...interface{}) ([]int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	c, ok := s.collections[collectionName]
+	if !ok {
+		return nil, fmt.Errorf("fakeapi: unknown collection %s", collectionName)
+	}
+
+	ids := make([]int, 0, len(records))
+	for _, record := range records {
+		data, err := json.Marshal(record)
+		if err != nil {
+			return ids, fmt.Errorf("fakeapi: failed to encode %T: %w", record, err)
+		}
+
+		recordMap := map[string]interface{}{}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&recordMap); err != nil {
+			return ids, fmt.Errorf("fakeapi: %T is not encoded as a JSON object: %w", record, err)
+		}
+
+		ids = append(ids, c.insert(recordMap))
+	}
+
+	return ids, nil
+}
+
+//Records gives the stored records of the collection
+func (s *Store) Records(collectionName string) []map[string]interface{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	c, ok := s.collections[collectionName]
+	if !ok {
+		return nil
+	}
+
+	records := make([]map[string]interface{}, 0, len(c.records))
+	for _, record := range c.records {
+		recordCopy := make(map[string]interface{}, len(record))
+		for key, value := range record {
+			recordCopy[key] = value
+		}
+		records = append(records, recordCopy)
+	}
+
+	return records
+}
+
+//controlParams are not used for filtering the records
+var controlParams = map[string]bool{
+	"request":            true,
+	"requestName":        true,
+	"requestID":          true,
+	"clientCode":         true,
+	"sessionKey":         true,
+	"partnerKey":         true,
+	"setContentType":     true,
+	"recordsOnPage":      true,
+	"pageNo":             true,
+	"changedSince":       true,
+	"orderBy":            true,
+	"orderByDir":         true,
+	"lang":               true,
+	"responseMode":       true,
+	"version":            true,
+	"getStockInfo":       true,
+	"getPriceListPrices": true,
+}
+
+type collection struct {
+	settings collectionSettings
+	fields   map[string]reflect.Type
+	rows     *rowsField
+	records  []map[string]interface{}
+	nextID   int
+}
+
+//rowsField is a slice of structs in the model which is saved from numbered parameters like productID1, amount1
+type rowsField struct {
+	name   string
+	fields map[string]reflect.Type
+}
+
+var rowParamRegexp = regexp.MustCompile(`^([A-Za-z]+?)(\d+)$`)
+
+func newCollection(settings collectionSettings) *collection {
+	c := &collection{settings: settings, nextID: 1}
+	if settings.model == nil {
+		return c
+	}
+
+	c.fields = map[string]reflect.Type{}
+	collectJSONFields(reflect.TypeOf(settings.model), c.fields)
+	for name, fieldType := range c.fields {
+		if fieldType.Kind() == reflect.Slice && fieldType.Elem().Kind() == reflect.Struct && name == "rows" {
+			c.rows = &rowsField{name: name, fields: map[string]reflect.Type{}}
+			collectJSONFields(fieldType.Elem(), c.rows.fields)
+		}
+	}
+
+	return c
+}
+
+//collectJSONFields maps the JSON names of the struct fields to their types, the fields of embedded structs are flattened
+func collectJSONFields(structType reflect.Type, fields map[string]reflect.Type) {
+	for structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			collectJSONFields(field.Type, fields)
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		fields[name] = fieldType
+	}
+}
+
+//insert adds the records to the collection, the records without an ID get the next free one
+func (c *collection) insert(record map[string]interface{}) int {
+	id := c.recordID(record)
+	if id == 0 {
+		id = c.nextID
+		c.setID(record, id)
+	}
+	if id >= c.nextID {
+		c.nextID = id + 1
+	}
+
+	if i := c.find(id); i >= 0 {
+		c.records[i] = record
+	} else {
+		c.records = append(c.records, record)
+	}
+
+	return id
+}
+
+func (c *collection) recordID(record map[string]interface{}) int {
+	for _, idField := range c.settings.recordIDFields {
+		if id, err := strconv.Atoi(fmt.Sprint(record[idField])); err == nil && id != 0 {
+			return id
+		}
+	}
+
+	return 0
+}
+
+func (c *collection) setID(record map[string]interface{}, id int) {
+	for _, idField := range c.settings.recordIDFields {
+		if c.fields == nil {
+			record[idField] = id
+			continue
+		}
+
+		value, err := convertValue(strconv.Itoa(id), c.fields[idField])
+		if err != nil {
+			value = id
+		}
+		record[idField] = value
+	}
+}
+
+func (c *collection) find(id int) int {
+	for i, record := range c.records {
+		if c.recordID(record) == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+//query gives the records matching the filters on the requested page and the total count of the matching records
+func (c *collection) query(params map[string]string) (page []map[string]interface{}, total int, apiErr sharedCommon.ApiError) {
+	recordsOnPage, pageNo := 20, 1
+	if value, ok := params["recordsOnPage"]; ok {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return nil, 0, sharedCommon.InvalidValue
+		}
+		recordsOnPage = parsed
+	}
+	if recordsOnPage > sharedCommon.MaxCountPerBulkRequestItem {
+		recordsOnPage = sharedCommon.MaxCountPerBulkRequestItem
+	}
+	if value, ok := params["pageNo"]; ok {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return nil, 0, sharedCommon.InvalidValue
+		}
+		pageNo = parsed
+	}
+
+	matching := make([]map[string]interface{}, 0, len(c.records))
+	for _, record := range c.records {
+		if c.matches(record, params) {
+			matching = append(matching, record)
+		}
+	}
+	sort.SliceStable(matching, func(i, j int) bool {
+		return c.recordID(matching[i]) < c.recordID(matching[j])
+	})
+
+	start := (pageNo - 1) * recordsOnPage
+	if start >= len(matching) {
+		return []map[string]interface{}{}, len(matching), 0
+	}
+	end := start + recordsOnPage
+	if end > len(matching) {
+		end = len(matching)
+	}
+
+	return matching[start:end], len(matching), 0
+}
+
+func (c *collection) matches(record map[string]interface{}, params map[string]string) bool {
+	for name, value := range params {
+		switch {
+		case controlParams[name]:
+			continue
+		case name == c.settings.idParam+"s":
+			if !containsID(value, c.recordID(record)) {
+				return false
+			}
+		case name == c.settings.idParam:
+			if strconv.Itoa(c.recordID(record)) != value {
+				return false
+			}
+		default:
+			recordValue, ok := record[name]
+			if !ok || !isScalar(recordValue) {
+				continue
+			}
+			if boolValue, ok := recordValue.(bool); ok {
+				if boolValue != (value == "1" || strings.EqualFold(value, "true")) {
+					return false
+				}
+				continue
+			}
+			if fmt.Sprint(recordValue) != value {
+				return false
+			}
+		}
+	}
+
+	if changedSince, ok := params["changedSince"]; ok {
+		since, err := strconv.ParseInt(changedSince, 10, 64)
+		lastModified, lastModifiedErr := strconv.ParseInt(fmt.Sprint(record["lastModified"]), 10, 64)
+		if err == nil && lastModifiedErr == nil && lastModified < since {
+			return false
+		}
+	}
+
+	return true
+}
+
+//save creates or updates a record from the request parameters
+func (c *collection) save(params map[string]string, now int64) (id int, apiErr sharedCommon.ApiError) {
+	record := map[string]interface{}{}
+	if idValue := params[c.settings.idParam]; idValue != "" && idValue != "0" {
+		parsedID, err := strconv.Atoi(idValue)
+		if err != nil {
+			return 0, sharedCommon.InvalidValue
+		}
+		i := c.find(parsedID)
+		if i < 0 {
+			return 0, sharedCommon.InvalidClassifierID
+		}
+		for key, value := range c.records[i] {
+			record[key] = value
+		}
+	} else {
+		c.setField(record, "added", strconv.FormatInt(now, 10))
+	}
+	c.setField(record, "lastModified", strconv.FormatInt(now, 10))
+
+	rows := map[int]map[string]interface{}{}
+	for name, value := range params {
+		if controlParams[name] || name == c.settings.idParam {
+			continue
+		}
+
+		if c.rows != nil {
+			if match := rowParamRegexp.FindStringSubmatch(name); match != nil {
+				if fieldType, ok := c.rows.fields[match[1]]; ok {
+					rowNo, _ := strconv.Atoi(match[2])
+					if rows[rowNo] == nil {
+						rows[rowNo] = map[string]interface{}{}
+					}
+					converted, err := convertValue(value, fieldType)
+					if err != nil {
+						return 0, sharedCommon.InvalidValue
+					}
+					rows[rowNo][match[1]] = converted
+					continue
+				}
+			}
+		}
+
+		if c.fields != nil {
+			if _, ok := c.fields[name]; !ok {
+				continue
+			}
+		}
+		if err := c.setField(record, name, value); err != nil {
+			return 0, sharedCommon.InvalidValue
+		}
+	}
+
+	if len(rows) > 0 {
+		rowNumbers := make([]int, 0, len(rows))
+		for rowNo := range rows {
+			rowNumbers = append(rowNumbers, rowNo)
+		}
+		sort.Ints(rowNumbers)
+
+		recordRows := make([]interface{}, 0, len(rows))
+		for _, rowNo := range rowNumbers {
+			recordRows = append(recordRows, rows[rowNo])
+		}
+		record[c.rows.name] = recordRows
+	}
+
+	return c.insert(record), 0
+}
+
+func (c *collection) setField(record map[string]interface{}, name, value string) error {
+	if c.fields == nil {
+		record[name] = value
+		return nil
+	}
+
+	fieldType, ok := c.fields[name]
+	if !ok {
+		return nil
+	}
+	converted, err := convertValue(value, fieldType)
+	if err != nil {
+		return err
+	}
+	record[name] = converted
+
+	return nil
+}
+
+func (c *collection) delete(id int) bool {
+	i := c.find(id)
+	if i < 0 {
+		return false
+	}
+	c.records = append(c.records[:i], c.records[i+1:]...)
+
+	return true
+}
+
+//convertValue converts the parameter value to the JSON type of the model field, the custom types of the SDK
+//accept strings, so the values of all other types are kept as strings
+func convertValue(value string, fieldType reflect.Type) (interface{}, error) {
+	if fieldType == nil {
+		return value, nil
+	}
+	if fieldType.Implements(jsonUnmarshalerType) || reflect.PtrTo(fieldType).Implements(jsonUnmarshalerType) {
+		return value, nil
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if value == "" {
+			return 0, nil
+		}
+		return strconv.ParseInt(value, 10, 64)
+	case reflect.Float32, reflect.Float64:
+		if value == "" {
+			return 0, nil
+		}
+		return strconv.ParseFloat(value, 64)
+	case reflect.Bool:
+		return value == "1" || strings.EqualFold(value, "true"), nil
+	}
+
+	return value, nil
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+func containsID(ids string, id int) bool {
+	for _, idStr := range strings.Split(ids, ",") {
+		if strings.TrimSpace(idStr) == strconv.Itoa(id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isScalar(value interface{}) bool {
+	switch value.(type) {
+	case string, bool, float64, int, int64, json.Number:
+		return true
+	}
+
+	return false
+}
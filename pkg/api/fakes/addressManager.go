@@ -0,0 +1,112 @@
+// Code generated by fakegen from addresses.Manager. DO NOT EDIT.
+
+package fakes
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/addresses"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+// AddressManager is a fake of addresses.Manager, see Fake for the behaviour of its methods
+type AddressManager struct {
+	*Fake
+	GetAddressesFunc      func(ctx context.Context, filters map[string]string) ([]sharedCommon.Address, error)
+	GetAddressesBulkFunc  func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (addresses.GetAddressesResponseBulk, error)
+	SaveAddressFunc       func(ctx context.Context, filters map[string]string) ([]sharedCommon.Address, error)
+	SaveAddressesBulkFunc func(ctx context.Context, addrMap []map[string]interface{}, attrs map[string]string) (addresses.SaveAddressesResponseBulk, error)
+	DeleteAddressFunc     func(ctx context.Context, filters map[string]string) error
+	DeleteAddressBulkFunc func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (addresses.DeleteAddressResponseBulk, error)
+}
+
+var _ addresses.Manager = (*AddressManager)(nil)
+
+// NewAddressManager creates a AddressManager with a new Fake
+func NewAddressManager() *AddressManager {
+	return &AddressManager{Fake: NewFake()}
+}
+
+// GetAddresses implements addresses.Manager
+func (f *AddressManager) GetAddresses(ctx context.Context, filters map[string]string) ([]sharedCommon.Address, error) {
+	var r0 []sharedCommon.Address
+	if err := f.record("GetAddresses", filters); err != nil {
+		return r0, err
+	}
+	if f.GetAddressesFunc != nil {
+		return f.GetAddressesFunc(ctx, filters)
+	}
+
+	err := f.handle("getAddresses", filters, &r0)
+
+	return r0, err
+}
+
+// GetAddressesBulk implements addresses.Manager
+func (f *AddressManager) GetAddressesBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (addresses.GetAddressesResponseBulk, error) {
+	var r0 addresses.GetAddressesResponseBulk
+	if err := f.record("GetAddressesBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.GetAddressesBulkFunc != nil {
+		return f.GetAddressesBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	err := f.handleBulk("getAddresses", bulkFilters, baseFilters, &r0)
+
+	return r0, err
+}
+
+// SaveAddress implements addresses.Manager
+func (f *AddressManager) SaveAddress(ctx context.Context, filters map[string]string) ([]sharedCommon.Address, error) {
+	var r0 []sharedCommon.Address
+	if err := f.record("SaveAddress", filters); err != nil {
+		return r0, err
+	}
+	if f.SaveAddressFunc != nil {
+		return f.SaveAddressFunc(ctx, filters)
+	}
+
+	err := f.handle("saveAddress", filters, &r0)
+
+	return r0, err
+}
+
+// SaveAddressesBulk implements addresses.Manager
+func (f *AddressManager) SaveAddressesBulk(ctx context.Context, addrMap []map[string]interface{}, attrs map[string]string) (addresses.SaveAddressesResponseBulk, error) {
+	var r0 addresses.SaveAddressesResponseBulk
+	if err := f.record("SaveAddressesBulk", addrMap, attrs); err != nil {
+		return r0, err
+	}
+	if f.SaveAddressesBulkFunc != nil {
+		return f.SaveAddressesBulkFunc(ctx, addrMap, attrs)
+	}
+
+	return r0, nil
+}
+
+// DeleteAddress implements addresses.Manager
+func (f *AddressManager) DeleteAddress(ctx context.Context, filters map[string]string) error {
+	if err := f.record("DeleteAddress", filters); err != nil {
+		return err
+	}
+	if f.DeleteAddressFunc != nil {
+		return f.DeleteAddressFunc(ctx, filters)
+	}
+
+	return f.handle("deleteAddress", filters, nil)
+}
+
+// DeleteAddressBulk implements addresses.Manager
+func (f *AddressManager) DeleteAddressBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (addresses.DeleteAddressResponseBulk, error) {
+	var r0 addresses.DeleteAddressResponseBulk
+	if err := f.record("DeleteAddressBulk", bulkRequest, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.DeleteAddressBulkFunc != nil {
+		return f.DeleteAddressBulkFunc(ctx, bulkRequest, baseFilters)
+	}
+
+	err := f.handleBulk("deleteAddress", bulkRequest, baseFilters, &r0)
+
+	return r0, err
+}
@@ -0,0 +1,62 @@
+// Code generated by fakegen from auth.Provider. DO NOT EDIT.
+
+package fakes
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/auth"
+)
+
+// AuthProvider is a fake of auth.Provider, see Fake for the behaviour of its methods
+type AuthProvider struct {
+	*Fake
+	VerifyIdentityTokenFunc func(ctx context.Context, jwt string) (*auth.SessionInfo, error)
+	GetIdentityTokenFunc    func(ctx context.Context) (*auth.IdentityToken, error)
+	GetJWTTokenFunc         func(ctx context.Context) (*auth.JwtToken, error)
+}
+
+var _ auth.Provider = (*AuthProvider)(nil)
+
+// NewAuthProvider creates a AuthProvider with a new Fake
+func NewAuthProvider() *AuthProvider {
+	return &AuthProvider{Fake: NewFake()}
+}
+
+// VerifyIdentityToken implements auth.Provider
+func (f *AuthProvider) VerifyIdentityToken(ctx context.Context, jwt string) (*auth.SessionInfo, error) {
+	var r0 *auth.SessionInfo
+	if err := f.record("VerifyIdentityToken", jwt); err != nil {
+		return r0, err
+	}
+	if f.VerifyIdentityTokenFunc != nil {
+		return f.VerifyIdentityTokenFunc(ctx, jwt)
+	}
+
+	return r0, nil
+}
+
+// GetIdentityToken implements auth.Provider
+func (f *AuthProvider) GetIdentityToken(ctx context.Context) (*auth.IdentityToken, error) {
+	var r0 *auth.IdentityToken
+	if err := f.record("GetIdentityToken"); err != nil {
+		return r0, err
+	}
+	if f.GetIdentityTokenFunc != nil {
+		return f.GetIdentityTokenFunc(ctx)
+	}
+
+	return r0, nil
+}
+
+// GetJWTToken implements auth.Provider
+func (f *AuthProvider) GetJWTToken(ctx context.Context) (*auth.JwtToken, error) {
+	var r0 *auth.JwtToken
+	if err := f.record("GetJWTToken"); err != nil {
+		return r0, err
+	}
+	if f.GetJWTTokenFunc != nil {
+		return f.GetJWTTokenFunc(ctx)
+	}
+
+	return r0, nil
+}
@@ -0,0 +1,48 @@
+// Code generated by fakegen from company.Manager. DO NOT EDIT.
+
+package fakes
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/company"
+)
+
+// CompanyManager is a fake of company.Manager, see Fake for the behaviour of its methods
+type CompanyManager struct {
+	*Fake
+	GetCompanyInfoFunc    func(ctx context.Context) (*company.Info, error)
+	GetConfParametersFunc func(ctx context.Context) (*company.ConfParameter, error)
+}
+
+var _ company.Manager = (*CompanyManager)(nil)
+
+// NewCompanyManager creates a CompanyManager with a new Fake
+func NewCompanyManager() *CompanyManager {
+	return &CompanyManager{Fake: NewFake()}
+}
+
+// GetCompanyInfo implements company.Manager
+func (f *CompanyManager) GetCompanyInfo(ctx context.Context) (*company.Info, error) {
+	var r0 *company.Info
+	if err := f.record("GetCompanyInfo"); err != nil {
+		return r0, err
+	}
+	if f.GetCompanyInfoFunc != nil {
+		return f.GetCompanyInfoFunc(ctx)
+	}
+
+	return r0, nil
+}
+
+// GetConfParameters implements company.Manager
+func (f *CompanyManager) GetConfParameters(ctx context.Context) (*company.ConfParameter, error) {
+	var r0 *company.ConfParameter
+	if err := f.record("GetConfParameters"); err != nil {
+		return r0, err
+	}
+	if f.GetConfParametersFunc != nil {
+		return f.GetConfParametersFunc(ctx)
+	}
+
+	return r0, nil
+}
@@ -0,0 +1,295 @@
+// Code generated by fakegen from customers.Manager. DO NOT EDIT.
+
+package fakes
+
+import (
+	"context"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/customers"
+)
+
+// CustomerManager is a fake of customers.Manager, see Fake for the behaviour of its methods
+type CustomerManager struct {
+	*Fake
+	SaveCustomerFunc                func(ctx context.Context, filters map[string]string) (*customers.CustomerImportReport, error)
+	SaveCustomerWithInputFunc       func(ctx context.Context, input customers.SaveCustomerInput) (*customers.CustomerImportReport, error)
+	SaveCustomerBulkFunc            func(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (customers.SaveCustomerResponseBulk, error)
+	GetCustomersFunc                func(ctx context.Context, filters map[string]string) ([]customers.Customer, error)
+	GetCustomersWithFiltersFunc     func(ctx context.Context, filters customers.GetCustomersFilters) ([]customers.Customer, error)
+	GetCustomersBulkFunc            func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (customers.GetCustomersResponseBulk, error)
+	DeleteCustomerFunc              func(ctx context.Context, filters map[string]string) error
+	DeleteCustomerBulkFunc          func(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (customers.DeleteCustomersResponseBulk, error)
+	VerifyCustomerUserFunc          func(ctx context.Context, username string, password string) (*customers.WebshopClient, error)
+	ValidateCustomerUsernameFunc    func(ctx context.Context, username string) (bool, error)
+	GetSuppliersFunc                func(ctx context.Context, filters map[string]string) ([]customers.Supplier, error)
+	GetSuppliersBulkFunc            func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (customers.GetSuppliersResponseBulk, error)
+	SaveSupplierFunc                func(ctx context.Context, filters map[string]string) (*customers.CustomerImportReport, error)
+	SaveSupplierBulkFunc            func(ctx context.Context, suppliers []map[string]interface{}, attrs map[string]string) (customers.SaveSuppliersResponseBulk, error)
+	DeleteSupplierFunc              func(ctx context.Context, filters map[string]string) error
+	DeleteSupplierBulkFunc          func(ctx context.Context, supplierMap []map[string]interface{}, attrs map[string]string) (customers.DeleteSuppliersResponseBulk, error)
+	AddCustomerRewardPointsFunc     func(ctx context.Context, filters map[string]string) (customers.AddCustomerRewardPointsResult, error)
+	AddCustomerRewardPointsBulkFunc func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (customers.AddCustomerRewardPointsResponseBulk, error)
+}
+
+var _ customers.Manager = (*CustomerManager)(nil)
+
+// NewCustomerManager creates a CustomerManager with a new Fake
+func NewCustomerManager() *CustomerManager {
+	return &CustomerManager{Fake: NewFake()}
+}
+
+// SaveCustomer implements customers.Manager
+func (f *CustomerManager) SaveCustomer(ctx context.Context, filters map[string]string) (*customers.CustomerImportReport, error) {
+	var r0 *customers.CustomerImportReport
+	if err := f.record("SaveCustomer", filters); err != nil {
+		return r0, err
+	}
+	if f.SaveCustomerFunc != nil {
+		return f.SaveCustomerFunc(ctx, filters)
+	}
+
+	err := f.handle("saveCustomer", filters, &r0)
+
+	return r0, err
+}
+
+// SaveCustomerWithInput implements customers.Manager
+func (f *CustomerManager) SaveCustomerWithInput(ctx context.Context, input customers.SaveCustomerInput) (*customers.CustomerImportReport, error) {
+	var r0 *customers.CustomerImportReport
+	if err := f.record("SaveCustomerWithInput", input); err != nil {
+		return r0, err
+	}
+	if f.SaveCustomerWithInputFunc != nil {
+		return f.SaveCustomerWithInputFunc(ctx, input)
+	}
+
+	encoded, err := sharedCommon.EncodeFilters(input)
+	if err != nil {
+		return r0, err
+	}
+
+	err = f.handle("saveCustomer", encoded, &r0)
+
+	return r0, err
+}
+
+// SaveCustomerBulk implements customers.Manager
+func (f *CustomerManager) SaveCustomerBulk(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (customers.SaveCustomerResponseBulk, error) {
+	var r0 customers.SaveCustomerResponseBulk
+	if err := f.record("SaveCustomerBulk", customerMap, attrs); err != nil {
+		return r0, err
+	}
+	if f.SaveCustomerBulkFunc != nil {
+		return f.SaveCustomerBulkFunc(ctx, customerMap, attrs)
+	}
+
+	err := f.handleBulk("saveCustomer", customerMap, attrs, &r0)
+
+	return r0, err
+}
+
+// GetCustomers implements customers.Manager
+func (f *CustomerManager) GetCustomers(ctx context.Context, filters map[string]string) ([]customers.Customer, error) {
+	var r0 []customers.Customer
+	if err := f.record("GetCustomers", filters); err != nil {
+		return r0, err
+	}
+	if f.GetCustomersFunc != nil {
+		return f.GetCustomersFunc(ctx, filters)
+	}
+
+	err := f.handle("getCustomers", filters, &r0)
+
+	return r0, err
+}
+
+// GetCustomersWithFilters implements customers.Manager
+func (f *CustomerManager) GetCustomersWithFilters(ctx context.Context, filters customers.GetCustomersFilters) ([]customers.Customer, error) {
+	var r0 []customers.Customer
+	if err := f.record("GetCustomersWithFilters", filters); err != nil {
+		return r0, err
+	}
+	if f.GetCustomersWithFiltersFunc != nil {
+		return f.GetCustomersWithFiltersFunc(ctx, filters)
+	}
+
+	encoded, err := sharedCommon.EncodeFilters(filters)
+	if err != nil {
+		return r0, err
+	}
+
+	err = f.handle("getCustomers", encoded, &r0)
+
+	return r0, err
+}
+
+// GetCustomersBulk implements customers.Manager
+func (f *CustomerManager) GetCustomersBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (customers.GetCustomersResponseBulk, error) {
+	var r0 customers.GetCustomersResponseBulk
+	if err := f.record("GetCustomersBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.GetCustomersBulkFunc != nil {
+		return f.GetCustomersBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	err := f.handleBulk("getCustomers", bulkFilters, baseFilters, &r0)
+
+	return r0, err
+}
+
+// DeleteCustomer implements customers.Manager
+func (f *CustomerManager) DeleteCustomer(ctx context.Context, filters map[string]string) error {
+	if err := f.record("DeleteCustomer", filters); err != nil {
+		return err
+	}
+	if f.DeleteCustomerFunc != nil {
+		return f.DeleteCustomerFunc(ctx, filters)
+	}
+
+	return f.handle("deleteCustomer", filters, nil)
+}
+
+// DeleteCustomerBulk implements customers.Manager
+func (f *CustomerManager) DeleteCustomerBulk(ctx context.Context, customerMap []map[string]interface{}, attrs map[string]string) (customers.DeleteCustomersResponseBulk, error) {
+	var r0 customers.DeleteCustomersResponseBulk
+	if err := f.record("DeleteCustomerBulk", customerMap, attrs); err != nil {
+		return r0, err
+	}
+	if f.DeleteCustomerBulkFunc != nil {
+		return f.DeleteCustomerBulkFunc(ctx, customerMap, attrs)
+	}
+
+	err := f.handleBulk("deleteCustomer", customerMap, attrs, &r0)
+
+	return r0, err
+}
+
+// VerifyCustomerUser implements customers.Manager
+func (f *CustomerManager) VerifyCustomerUser(ctx context.Context, username string, password string) (*customers.WebshopClient, error) {
+	var r0 *customers.WebshopClient
+	if err := f.record("VerifyCustomerUser", username, password); err != nil {
+		return r0, err
+	}
+	if f.VerifyCustomerUserFunc != nil {
+		return f.VerifyCustomerUserFunc(ctx, username, password)
+	}
+
+	return r0, nil
+}
+
+// ValidateCustomerUsername implements customers.Manager
+func (f *CustomerManager) ValidateCustomerUsername(ctx context.Context, username string) (bool, error) {
+	var r0 bool
+	if err := f.record("ValidateCustomerUsername", username); err != nil {
+		return r0, err
+	}
+	if f.ValidateCustomerUsernameFunc != nil {
+		return f.ValidateCustomerUsernameFunc(ctx, username)
+	}
+
+	return r0, nil
+}
+
+// GetSuppliers implements customers.Manager
+func (f *CustomerManager) GetSuppliers(ctx context.Context, filters map[string]string) ([]customers.Supplier, error) {
+	var r0 []customers.Supplier
+	if err := f.record("GetSuppliers", filters); err != nil {
+		return r0, err
+	}
+	if f.GetSuppliersFunc != nil {
+		return f.GetSuppliersFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// GetSuppliersBulk implements customers.Manager
+func (f *CustomerManager) GetSuppliersBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (customers.GetSuppliersResponseBulk, error) {
+	var r0 customers.GetSuppliersResponseBulk
+	if err := f.record("GetSuppliersBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.GetSuppliersBulkFunc != nil {
+		return f.GetSuppliersBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// SaveSupplier implements customers.Manager
+func (f *CustomerManager) SaveSupplier(ctx context.Context, filters map[string]string) (*customers.CustomerImportReport, error) {
+	var r0 *customers.CustomerImportReport
+	if err := f.record("SaveSupplier", filters); err != nil {
+		return r0, err
+	}
+	if f.SaveSupplierFunc != nil {
+		return f.SaveSupplierFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// SaveSupplierBulk implements customers.Manager
+func (f *CustomerManager) SaveSupplierBulk(ctx context.Context, suppliers []map[string]interface{}, attrs map[string]string) (customers.SaveSuppliersResponseBulk, error) {
+	var r0 customers.SaveSuppliersResponseBulk
+	if err := f.record("SaveSupplierBulk", suppliers, attrs); err != nil {
+		return r0, err
+	}
+	if f.SaveSupplierBulkFunc != nil {
+		return f.SaveSupplierBulkFunc(ctx, suppliers, attrs)
+	}
+
+	return r0, nil
+}
+
+// DeleteSupplier implements customers.Manager
+func (f *CustomerManager) DeleteSupplier(ctx context.Context, filters map[string]string) error {
+	if err := f.record("DeleteSupplier", filters); err != nil {
+		return err
+	}
+	if f.DeleteSupplierFunc != nil {
+		return f.DeleteSupplierFunc(ctx, filters)
+	}
+
+	return nil
+}
+
+// DeleteSupplierBulk implements customers.Manager
+func (f *CustomerManager) DeleteSupplierBulk(ctx context.Context, supplierMap []map[string]interface{}, attrs map[string]string) (customers.DeleteSuppliersResponseBulk, error) {
+	var r0 customers.DeleteSuppliersResponseBulk
+	if err := f.record("DeleteSupplierBulk", supplierMap, attrs); err != nil {
+		return r0, err
+	}
+	if f.DeleteSupplierBulkFunc != nil {
+		return f.DeleteSupplierBulkFunc(ctx, supplierMap, attrs)
+	}
+
+	return r0, nil
+}
+
+// AddCustomerRewardPoints implements customers.Manager
+func (f *CustomerManager) AddCustomerRewardPoints(ctx context.Context, filters map[string]string) (customers.AddCustomerRewardPointsResult, error) {
+	var r0 customers.AddCustomerRewardPointsResult
+	if err := f.record("AddCustomerRewardPoints", filters); err != nil {
+		return r0, err
+	}
+	if f.AddCustomerRewardPointsFunc != nil {
+		return f.AddCustomerRewardPointsFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// AddCustomerRewardPointsBulk implements customers.Manager
+func (f *CustomerManager) AddCustomerRewardPointsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (customers.AddCustomerRewardPointsResponseBulk, error) {
+	var r0 customers.AddCustomerRewardPointsResponseBulk
+	if err := f.record("AddCustomerRewardPointsBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.AddCustomerRewardPointsBulkFunc != nil {
+		return f.AddCustomerRewardPointsBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
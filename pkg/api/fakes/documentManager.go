@@ -0,0 +1,48 @@
+// Code generated by fakegen from documents.Manager. DO NOT EDIT.
+
+package fakes
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/documents"
+)
+
+// DocumentManager is a fake of documents.Manager, see Fake for the behaviour of its methods
+type DocumentManager struct {
+	*Fake
+	GetPurchaseDocumentsFunc     func(ctx context.Context, filters map[string]string) ([]documents.PurchaseDocument, error)
+	GetPurchaseDocumentsBulkFunc func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (documents.GetPurchaseDocumentResponseBulk, error)
+}
+
+var _ documents.Manager = (*DocumentManager)(nil)
+
+// NewDocumentManager creates a DocumentManager with a new Fake
+func NewDocumentManager() *DocumentManager {
+	return &DocumentManager{Fake: NewFake()}
+}
+
+// GetPurchaseDocuments implements documents.Manager
+func (f *DocumentManager) GetPurchaseDocuments(ctx context.Context, filters map[string]string) ([]documents.PurchaseDocument, error) {
+	var r0 []documents.PurchaseDocument
+	if err := f.record("GetPurchaseDocuments", filters); err != nil {
+		return r0, err
+	}
+	if f.GetPurchaseDocumentsFunc != nil {
+		return f.GetPurchaseDocumentsFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// GetPurchaseDocumentsBulk implements documents.Manager
+func (f *DocumentManager) GetPurchaseDocumentsBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (documents.GetPurchaseDocumentResponseBulk, error) {
+	var r0 documents.GetPurchaseDocumentResponseBulk
+	if err := f.record("GetPurchaseDocumentsBulk", bulkRequest, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.GetPurchaseDocumentsBulkFunc != nil {
+		return f.GetPurchaseDocumentsBulkFunc(ctx, bulkRequest, baseFilters)
+	}
+
+	return r0, nil
+}
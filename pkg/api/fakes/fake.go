@@ -0,0 +1,232 @@
+//Package fakes provides fake implementations of the Manager interfaces of the SDK for the tests of the code using them.
+//The fakes are generated from the interfaces by internal/fakegen, run go generate in this directory after changing them
+package fakes
+
+//go:generate go run ../../../internal/fakegen/cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/fakeapi"
+	"reflect"
+	"sync"
+)
+
+//Call is a recorded call of a fake method, Args are the arguments except the context
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+//Fake is the shared part of the generated fakes. A method of a fake gives the error set with SetError if any,
+//otherwise the result of its Func field if it's set. The methods of the get, save and delete requests which
+//fakeapi.Store supports fall back to the Store, so the saved records can be read back, the rest give zero values.
+//This includes the methods with the typed inputs which are encoded with sharedCommon.EncodeFilters, the bulk
+//methods which serve each sub-request with the Store and the count methods which give the total of the get request
+type Fake struct {
+	//Store keeps the records of the get, save and delete requests, share it between fakes to see the same data
+	Store *fakeapi.Store
+
+	lock   sync.Mutex
+	calls  []Call
+	errors map[string]error
+}
+
+//NewFake creates a Fake with an empty store
+func NewFake() *Fake {
+	return &Fake{
+		Store:  fakeapi.NewStore(),
+		errors: map[string]error{},
+	}
+}
+
+//SetError makes the calls of the method fail with err, nil removes the error
+func (f *Fake) SetError(method string, err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.errors == nil {
+		f.errors = map[string]error{}
+	}
+	if err == nil {
+		delete(f.errors, method)
+		return
+	}
+	f.errors[method] = err
+}
+
+//Calls gives the recorded calls of all methods in the order they were made
+func (f *Fake) Calls() []Call {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return append([]Call{}, f.calls...)
+}
+
+//CallsOf gives the recorded calls of the method
+func (f *Fake) CallsOf(method string) []Call {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	var calls []Call
+	for _, call := range f.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+//CallCount tells how many times the method was called
+func (f *Fake) CallCount(method string) int {
+	return len(f.CallsOf(method))
+}
+
+//Reset forgets the recorded calls and the errors set with SetError, the Store is kept
+func (f *Fake) Reset() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.calls = nil
+	f.errors = map[string]error{}
+}
+
+//record adds the call to the recorded ones and gives the error set for the method
+func (f *Fake) record(method string, args ...interface{}) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.calls = append(f.calls, Call{Method: method, Args: args})
+
+	return f.errors[method]
+}
+
+//handle serves the request with the Store and decodes the records into dest. A slice gets all records,
+//a struct or a pointer to a struct gets the first one
+func (f *Fake) handle(requestName string, filters map[string]string, dest interface{}) error {
+	result := f.Store.Handle(requestName, filters)
+	if result.ErrorCode != 0 {
+		return storeError(requestName, result)
+	}
+	if dest == nil {
+		return nil
+	}
+
+	switch reflect.ValueOf(dest).Elem().Kind() {
+	case reflect.Slice:
+		return decode(result.Records, dest)
+	case reflect.Struct, reflect.Ptr:
+		if len(result.Records) == 0 {
+			return nil
+		}
+		return decode(result.Records[0], dest)
+	}
+
+	return nil
+}
+
+//handleID serves the save request with the Store and decodes the idField of the response record into dest,
+//it's used by the methods which give only the ID of the saved record
+func (f *Fake) handleID(requestName, idField string, filters map[string]string, dest interface{}) error {
+	result := f.Store.Handle(requestName, filters)
+	if result.ErrorCode != 0 {
+		return storeError(requestName, result)
+	}
+	if len(result.Records) == 0 {
+		return nil
+	}
+
+	record, ok := result.Records[0].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("fakes: unexpected record %T in the response of %s", result.Records[0], requestName)
+	}
+
+	return decode(record[idField], dest)
+}
+
+//handleCount serves the get request with the Store and gives the total count of the matching records
+func (f *Fake) handleCount(requestName string, filters map[string]string) (int, error) {
+	result := f.Store.Handle(requestName, filters)
+	if result.ErrorCode != 0 {
+		return 0, storeError(requestName, result)
+	}
+
+	return result.Total, nil
+}
+
+type bulkResponse struct {
+	Status   sharedCommon.Status `json:"status"`
+	Requests []bulkResponseItem  `json:"requests"`
+}
+
+type bulkResponseItem struct {
+	Status  sharedCommon.StatusBulk `json:"status"`
+	Records []interface{}           `json:"records"`
+}
+
+//handleBulk serves each sub-request with the Store and decodes the bulk response into dest, the failed
+//sub-requests get their error statuses in the response like in the API
+func (f *Fake) handleBulk(requestName string, bulkFilters []map[string]interface{}, baseFilters map[string]string, dest interface{}) error {
+	resp := bulkResponse{
+		Status:   sharedCommon.Status{ResponseStatus: "ok"},
+		Requests: make([]bulkResponseItem, 0, len(bulkFilters)),
+	}
+
+	for _, bulkFilter := range bulkFilters {
+		params := make(map[string]string, len(baseFilters)+len(bulkFilter))
+		for key, value := range baseFilters {
+			params[key] = value
+		}
+		for key, value := range bulkFilter {
+			params[key] = fmt.Sprint(value)
+		}
+
+		result := f.Store.Handle(requestName, params)
+		status := sharedCommon.Status{
+			Request:           requestName,
+			ResponseStatus:    "ok",
+			ErrorCode:         result.ErrorCode,
+			ErrorField:        result.ErrorField,
+			RecordsTotal:      result.Total,
+			RecordsInResponse: len(result.Records),
+		}
+		if result.ErrorCode != 0 {
+			status.ResponseStatus = "error"
+		}
+
+		resp.Requests = append(resp.Requests, bulkResponseItem{
+			Status: sharedCommon.StatusBulk{
+				RequestName: requestName,
+				RequestID:   params["requestID"],
+				Status:      status,
+			},
+			Records: result.Records,
+		})
+	}
+
+	return decode(resp, dest)
+}
+
+func storeError(requestName string, result fakeapi.Result) error {
+	return sharedCommon.NewFromResponseStatus(&sharedCommon.Status{
+		Request:        requestName,
+		ResponseStatus: "error",
+		ErrorCode:      result.ErrorCode,
+		ErrorField:     result.ErrorField,
+	})
+}
+
+func decode(records interface{}, dest interface{}) error {
+	data, err := json.Marshal(records)
+	if err != nil {
+		return fmt.Errorf("fakes: failed to encode records: %w", err)
+	}
+
+	if err := json.Unmarshal(data, dest); err != nil {
+		return fmt.Errorf("fakes: failed to decode records into %T: %w", dest, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package fakes
+
+import (
+	"context"
+	"errors"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/fakeapi"
+	"github.com/erply/api-go-wrapper/pkg/api/products"
+	"github.com/erply/api-go-wrapper/pkg/api/sales"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSaveGetDeleteRoundTrip(t *testing.T) {
+	fake := NewProductManager()
+	ctx := context.Background()
+
+	saveResult, err := fake.SaveProduct(ctx, map[string]string{"name": "chair", "code": "CH1", "groupID": "3"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, saveResult.ProductID)
+
+	_, err = fake.SaveProduct(ctx, map[string]string{"name": "table", "code": "TB1"})
+	assert.NoError(t, err)
+
+	prods, err := fake.GetProducts(ctx, map[string]string{"code": "CH1"})
+	if assert.NoError(t, err) && assert.Len(t, prods, 1) {
+		assert.Equal(t, 1, prods[0].ProductID)
+		assert.Equal(t, "chair", prods[0].Name)
+		assert.Equal(t, uint(3), prods[0].GroupID)
+	}
+
+	assert.NoError(t, fake.DeleteProduct(ctx, map[string]string{"productID": "1"}))
+
+	prods, err = fake.GetProducts(ctx, map[string]string{})
+	if assert.NoError(t, err) && assert.Len(t, prods, 1) {
+		assert.Equal(t, 2, prods[0].ProductID)
+	}
+
+	err = fake.DeleteProduct(ctx, map[string]string{"productID": "1"})
+	erplyErr := &sharedCommon.ErplyError{}
+	if assert.True(t, errors.As(err, &erplyErr)) {
+		assert.Equal(t, sharedCommon.InvalidClassifierID, erplyErr.Code)
+		assert.Equal(t, "deleteProduct", erplyErr.Request)
+	}
+}
+
+func TestStubsAndErrors(t *testing.T) {
+	fake := NewProductManager()
+	ctx := context.Background()
+
+	fake.GetProductsCountFunc = func(ctx context.Context, filters map[string]string) (int, error) {
+		return 42, nil
+	}
+	count, err := fake.GetProductsCount(ctx, map[string]string{"active": "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, count)
+
+	stubErr := errors.New("failure")
+	fake.SetError("GetProducts", stubErr)
+	_, err = fake.GetProducts(ctx, map[string]string{})
+	assert.Equal(t, stubErr, err)
+
+	fake.SetError("GetProducts", nil)
+	_, err = fake.GetProducts(ctx, map[string]string{})
+	assert.NoError(t, err)
+
+	resp, err := fake.GetProductsBulk(ctx, []map[string]interface{}{{"productID": 1}}, map[string]string{})
+	if assert.NoError(t, err) && assert.Len(t, resp.BulkItems, 1) {
+		assert.Equal(t, "ok", resp.BulkItems[0].Status.ResponseStatus)
+		assert.Len(t, resp.BulkItems[0].Products, 0)
+	}
+
+	assert.Equal(t, []Call{
+		{Method: "GetProductsCount", Args: []interface{}{map[string]string{"active": "1"}}},
+		{Method: "GetProducts", Args: []interface{}{map[string]string{}}},
+		{Method: "GetProducts", Args: []interface{}{map[string]string{}}},
+		{Method: "GetProductsBulk", Args: []interface{}{[]map[string]interface{}{{"productID": 1}}, map[string]string{}}},
+	}, fake.Calls())
+	assert.Equal(t, 2, fake.CallCount("GetProducts"))
+
+	fake.Reset()
+	assert.Len(t, fake.Calls(), 0)
+}
+
+func TestTypedInputBulkAndCount(t *testing.T) {
+	fake := NewProductManager()
+	ctx := context.Background()
+
+	saveResult, err := fake.SaveProductWithInput(ctx, products.SaveProductInput{Name: "chair", Code: "CH1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, saveResult.ProductID)
+
+	bulkSaveResp, err := fake.SaveProductBulk(ctx, []map[string]interface{}{
+		{"name": "table", "code": "TB1"},
+		{"productID": 10, "name": "missing"},
+	}, map[string]string{})
+	if assert.NoError(t, err) && assert.Len(t, bulkSaveResp.BulkItems, 2) {
+		if assert.Len(t, bulkSaveResp.BulkItems[0].Products, 1) {
+			assert.Equal(t, 2, bulkSaveResp.BulkItems[0].Products[0].ProductID)
+		}
+		assert.Equal(t, "error", bulkSaveResp.BulkItems[1].Status.ResponseStatus)
+		assert.NotEqual(t, sharedCommon.ApiError(0), bulkSaveResp.BulkItems[1].Status.ErrorCode)
+	}
+
+	prods, err := fake.GetProductsWithFilters(ctx, products.GetProductsFilters{Code: "TB1"})
+	if assert.NoError(t, err) && assert.Len(t, prods, 1) {
+		assert.Equal(t, "table", prods[0].Name)
+	}
+
+	count, err := fake.GetProductsCount(ctx, map[string]string{"recordsOnPage": "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	bulkGetResp, err := fake.GetProductsBulk(ctx, []map[string]interface{}{
+		{"productID": 1, "requestID": "first"},
+		{"code": "TB1"},
+	}, map[string]string{})
+	if assert.NoError(t, err) && assert.Len(t, bulkGetResp.BulkItems, 2) {
+		assert.Equal(t, "first", bulkGetResp.BulkItems[0].Status.RequestID)
+		assert.Equal(t, 1, bulkGetResp.BulkItems[0].Status.RecordsTotal)
+		if assert.Len(t, bulkGetResp.BulkItems[1].Products, 1) {
+			assert.Equal(t, 2, bulkGetResp.BulkItems[1].Products[0].ProductID)
+		}
+	}
+}
+
+func TestSharedFake(t *testing.T) {
+	shared := NewFake()
+	salesFake := &SalesManager{Fake: shared}
+	ctx := context.Background()
+
+	paymentID, err := salesFake.SavePayment(ctx, map[string]string{"documentID": "5", "sum": "10.5"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), paymentID)
+
+	payments, err := salesFake.GetPayments(ctx, map[string]string{"paymentID": "1"})
+	if assert.NoError(t, err) && assert.Len(t, payments, 1) {
+		assert.Equal(t, 5, payments[0].DocumentID)
+	}
+
+	var _ sales.Manager = salesFake
+	addressFake := &AddressManager{Fake: shared}
+	_, err = addressFake.GetAddresses(ctx, map[string]string{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SavePayment", "GetPayments", "GetAddresses"}, callNames(shared.Calls()))
+}
+
+func TestHandleIDDecodesTheIDField(t *testing.T) {
+	fake := NewFake()
+	assert.Equal(t, "invoiceID", fake.Store.SaveIDField("saveSalesDocument"))
+	assert.Equal(t, "", fake.Store.SaveIDField("getSalesDocuments"))
+
+	_, err := fake.Store.Insert(fakeapi.SalesDocuments, map[string]interface{}{"number": "1"}, map[string]interface{}{"number": "2"})
+	assert.NoError(t, err)
+
+	var id int
+	assert.NoError(t, fake.handleID("saveSalesDocument", "invoiceID", map[string]string{"number": "3"}, &id))
+	assert.Equal(t, 3, id)
+
+	assert.Error(t, fake.handleID("unknownRequest", "id", map[string]string{}, &id))
+}
+
+func callNames(calls []Call) []string {
+	names := make([]string, 0, len(calls))
+	for _, call := range calls {
+		names = append(names, call.Method)
+	}
+
+	return names
+}
@@ -0,0 +1,34 @@
+// Code generated by fakegen from pos.Manager. DO NOT EDIT.
+
+package fakes
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/pos"
+)
+
+// PosManager is a fake of pos.Manager, see Fake for the behaviour of its methods
+type PosManager struct {
+	*Fake
+	GetPointsOfSaleFunc func(ctx context.Context, filters map[string]string) ([]pos.PointOfSale, error)
+}
+
+var _ pos.Manager = (*PosManager)(nil)
+
+// NewPosManager creates a PosManager with a new Fake
+func NewPosManager() *PosManager {
+	return &PosManager{Fake: NewFake()}
+}
+
+// GetPointsOfSale implements pos.Manager
+func (f *PosManager) GetPointsOfSale(ctx context.Context, filters map[string]string) ([]pos.PointOfSale, error) {
+	var r0 []pos.PointOfSale
+	if err := f.record("GetPointsOfSale", filters); err != nil {
+		return r0, err
+	}
+	if f.GetPointsOfSaleFunc != nil {
+		return f.GetPointsOfSaleFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
@@ -0,0 +1,326 @@
+// Code generated by fakegen from prices.Manager. DO NOT EDIT.
+
+package fakes
+
+import (
+	"context"
+	"github.com/erply/api-go-wrapper/pkg/api/prices"
+)
+
+// PriceManager is a fake of prices.Manager, see Fake for the behaviour of its methods
+type PriceManager struct {
+	*Fake
+	GetSupplierPriceListsFunc                   func(ctx context.Context, filters map[string]string) ([]prices.PriceList, error)
+	AddProductToSupplierPriceListFunc           func(ctx context.Context, filters map[string]string) (*prices.ChangeProductToSupplierPriceListResult, error)
+	EditProductToSupplierPriceListFunc          func(ctx context.Context, filters map[string]string) (*prices.ChangeProductToSupplierPriceListResult, error)
+	ChangeProductToSupplierPriceListBulkFunc    func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (prices.ChangeProductToSupplierPriceListResponseBulk, error)
+	GetSupplierPriceListsBulkFunc               func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.GetPriceListsResponseBulk, error)
+	GetProductsInPriceListFunc                  func(ctx context.Context, filters map[string]string) ([]prices.ProductsInPriceList, error)
+	GetProductsInPriceListWithStatusFunc        func(ctx context.Context, filters map[string]string) (prices.GetProductsInPriceListResponse, error)
+	GetProductsInPriceListBulkFunc              func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.GetProductsInPriceListResponseBulk, error)
+	GetProductsInSupplierPriceListFunc          func(ctx context.Context, filters map[string]string) ([]prices.ProductsInSupplierPriceList, error)
+	GetProductsInSupplierPriceListBulkFunc      func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.ProductsInSupplierPriceListResponseBulk, error)
+	DeleteProductsFromSupplierPriceListFunc     func(ctx context.Context, filters map[string]string) (*prices.DeleteProductsFromSupplierPriceListResult, error)
+	DeleteProductsFromSupplierPriceListBulkFunc func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.DeleteProductsFromSupplierPriceListResponseBulk, error)
+	SaveSupplierPriceListFunc                   func(ctx context.Context, filters map[string]string) (*prices.SaveSupplierPriceListResult, error)
+	SaveSupplierPriceListBulkFunc               func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (prices.SaveSupplierPriceListResponseBulk, error)
+	SavePriceListFunc                           func(ctx context.Context, filters map[string]string) (*prices.SavePriceListResult, error)
+	SavePriceListBulkFunc                       func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (prices.SavePriceListResponseBulk, error)
+	AddProductToPriceListFunc                   func(ctx context.Context, filters map[string]string) (*prices.ChangeProductToPriceListResult, error)
+	EditProductToPriceListFunc                  func(ctx context.Context, filters map[string]string) (*prices.ChangeProductToPriceListResult, error)
+	ChangeProductToPriceListBulkFunc            func(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (prices.ChangeProductToPriceListResponseBulk, error)
+	DeleteProductsFromPriceListFunc             func(ctx context.Context, filters map[string]string) (*prices.DeleteProductsFromPriceListResult, error)
+	DeleteProductsFromPriceListBulkFunc         func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.DeleteProductsFromPriceListResponseBulk, error)
+}
+
+var _ prices.Manager = (*PriceManager)(nil)
+
+// NewPriceManager creates a PriceManager with a new Fake
+func NewPriceManager() *PriceManager {
+	return &PriceManager{Fake: NewFake()}
+}
+
+// GetSupplierPriceLists implements prices.Manager
+func (f *PriceManager) GetSupplierPriceLists(ctx context.Context, filters map[string]string) ([]prices.PriceList, error) {
+	var r0 []prices.PriceList
+	if err := f.record("GetSupplierPriceLists", filters); err != nil {
+		return r0, err
+	}
+	if f.GetSupplierPriceListsFunc != nil {
+		return f.GetSupplierPriceListsFunc(ctx, filters)
+	}
+
+	err := f.handle("getSupplierPriceLists", filters, &r0)
+
+	return r0, err
+}
+
+// AddProductToSupplierPriceList implements prices.Manager
+func (f *PriceManager) AddProductToSupplierPriceList(ctx context.Context, filters map[string]string) (*prices.ChangeProductToSupplierPriceListResult, error) {
+	var r0 *prices.ChangeProductToSupplierPriceListResult
+	if err := f.record("AddProductToSupplierPriceList", filters); err != nil {
+		return r0, err
+	}
+	if f.AddProductToSupplierPriceListFunc != nil {
+		return f.AddProductToSupplierPriceListFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// EditProductToSupplierPriceList implements prices.Manager
+func (f *PriceManager) EditProductToSupplierPriceList(ctx context.Context, filters map[string]string) (*prices.ChangeProductToSupplierPriceListResult, error) {
+	var r0 *prices.ChangeProductToSupplierPriceListResult
+	if err := f.record("EditProductToSupplierPriceList", filters); err != nil {
+		return r0, err
+	}
+	if f.EditProductToSupplierPriceListFunc != nil {
+		return f.EditProductToSupplierPriceListFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// ChangeProductToSupplierPriceListBulk implements prices.Manager
+func (f *PriceManager) ChangeProductToSupplierPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (prices.ChangeProductToSupplierPriceListResponseBulk, error) {
+	var r0 prices.ChangeProductToSupplierPriceListResponseBulk
+	if err := f.record("ChangeProductToSupplierPriceListBulk", bulkRequest, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.ChangeProductToSupplierPriceListBulkFunc != nil {
+		return f.ChangeProductToSupplierPriceListBulkFunc(ctx, bulkRequest, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// GetSupplierPriceListsBulk implements prices.Manager
+func (f *PriceManager) GetSupplierPriceListsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.GetPriceListsResponseBulk, error) {
+	var r0 prices.GetPriceListsResponseBulk
+	if err := f.record("GetSupplierPriceListsBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.GetSupplierPriceListsBulkFunc != nil {
+		return f.GetSupplierPriceListsBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	err := f.handleBulk("getSupplierPriceLists", bulkFilters, baseFilters, &r0)
+
+	return r0, err
+}
+
+// GetProductsInPriceList implements prices.Manager
+func (f *PriceManager) GetProductsInPriceList(ctx context.Context, filters map[string]string) ([]prices.ProductsInPriceList, error) {
+	var r0 []prices.ProductsInPriceList
+	if err := f.record("GetProductsInPriceList", filters); err != nil {
+		return r0, err
+	}
+	if f.GetProductsInPriceListFunc != nil {
+		return f.GetProductsInPriceListFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// GetProductsInPriceListWithStatus implements prices.Manager
+func (f *PriceManager) GetProductsInPriceListWithStatus(ctx context.Context, filters map[string]string) (prices.GetProductsInPriceListResponse, error) {
+	var r0 prices.GetProductsInPriceListResponse
+	if err := f.record("GetProductsInPriceListWithStatus", filters); err != nil {
+		return r0, err
+	}
+	if f.GetProductsInPriceListWithStatusFunc != nil {
+		return f.GetProductsInPriceListWithStatusFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// GetProductsInPriceListBulk implements prices.Manager
+func (f *PriceManager) GetProductsInPriceListBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.GetProductsInPriceListResponseBulk, error) {
+	var r0 prices.GetProductsInPriceListResponseBulk
+	if err := f.record("GetProductsInPriceListBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.GetProductsInPriceListBulkFunc != nil {
+		return f.GetProductsInPriceListBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// GetProductsInSupplierPriceList implements prices.Manager
+func (f *PriceManager) GetProductsInSupplierPriceList(ctx context.Context, filters map[string]string) ([]prices.ProductsInSupplierPriceList, error) {
+	var r0 []prices.ProductsInSupplierPriceList
+	if err := f.record("GetProductsInSupplierPriceList", filters); err != nil {
+		return r0, err
+	}
+	if f.GetProductsInSupplierPriceListFunc != nil {
+		return f.GetProductsInSupplierPriceListFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// GetProductsInSupplierPriceListBulk implements prices.Manager
+func (f *PriceManager) GetProductsInSupplierPriceListBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.ProductsInSupplierPriceListResponseBulk, error) {
+	var r0 prices.ProductsInSupplierPriceListResponseBulk
+	if err := f.record("GetProductsInSupplierPriceListBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.GetProductsInSupplierPriceListBulkFunc != nil {
+		return f.GetProductsInSupplierPriceListBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// DeleteProductsFromSupplierPriceList implements prices.Manager
+func (f *PriceManager) DeleteProductsFromSupplierPriceList(ctx context.Context, filters map[string]string) (*prices.DeleteProductsFromSupplierPriceListResult, error) {
+	var r0 *prices.DeleteProductsFromSupplierPriceListResult
+	if err := f.record("DeleteProductsFromSupplierPriceList", filters); err != nil {
+		return r0, err
+	}
+	if f.DeleteProductsFromSupplierPriceListFunc != nil {
+		return f.DeleteProductsFromSupplierPriceListFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// DeleteProductsFromSupplierPriceListBulk implements prices.Manager
+func (f *PriceManager) DeleteProductsFromSupplierPriceListBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.DeleteProductsFromSupplierPriceListResponseBulk, error) {
+	var r0 prices.DeleteProductsFromSupplierPriceListResponseBulk
+	if err := f.record("DeleteProductsFromSupplierPriceListBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.DeleteProductsFromSupplierPriceListBulkFunc != nil {
+		return f.DeleteProductsFromSupplierPriceListBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// SaveSupplierPriceList implements prices.Manager
+func (f *PriceManager) SaveSupplierPriceList(ctx context.Context, filters map[string]string) (*prices.SaveSupplierPriceListResult, error) {
+	var r0 *prices.SaveSupplierPriceListResult
+	if err := f.record("SaveSupplierPriceList", filters); err != nil {
+		return r0, err
+	}
+	if f.SaveSupplierPriceListFunc != nil {
+		return f.SaveSupplierPriceListFunc(ctx, filters)
+	}
+
+	err := f.handle("saveSupplierPriceList", filters, &r0)
+
+	return r0, err
+}
+
+// SaveSupplierPriceListBulk implements prices.Manager
+func (f *PriceManager) SaveSupplierPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (prices.SaveSupplierPriceListResponseBulk, error) {
+	var r0 prices.SaveSupplierPriceListResponseBulk
+	if err := f.record("SaveSupplierPriceListBulk", bulkRequest, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.SaveSupplierPriceListBulkFunc != nil {
+		return f.SaveSupplierPriceListBulkFunc(ctx, bulkRequest, baseFilters)
+	}
+
+	err := f.handleBulk("saveSupplierPriceList", bulkRequest, baseFilters, &r0)
+
+	return r0, err
+}
+
+// SavePriceList implements prices.Manager
+func (f *PriceManager) SavePriceList(ctx context.Context, filters map[string]string) (*prices.SavePriceListResult, error) {
+	var r0 *prices.SavePriceListResult
+	if err := f.record("SavePriceList", filters); err != nil {
+		return r0, err
+	}
+	if f.SavePriceListFunc != nil {
+		return f.SavePriceListFunc(ctx, filters)
+	}
+
+	err := f.handle("savePriceList", filters, &r0)
+
+	return r0, err
+}
+
+// SavePriceListBulk implements prices.Manager
+func (f *PriceManager) SavePriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (prices.SavePriceListResponseBulk, error) {
+	var r0 prices.SavePriceListResponseBulk
+	if err := f.record("SavePriceListBulk", bulkRequest, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.SavePriceListBulkFunc != nil {
+		return f.SavePriceListBulkFunc(ctx, bulkRequest, baseFilters)
+	}
+
+	err := f.handleBulk("savePriceList", bulkRequest, baseFilters, &r0)
+
+	return r0, err
+}
+
+// AddProductToPriceList implements prices.Manager
+func (f *PriceManager) AddProductToPriceList(ctx context.Context, filters map[string]string) (*prices.ChangeProductToPriceListResult, error) {
+	var r0 *prices.ChangeProductToPriceListResult
+	if err := f.record("AddProductToPriceList", filters); err != nil {
+		return r0, err
+	}
+	if f.AddProductToPriceListFunc != nil {
+		return f.AddProductToPriceListFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// EditProductToPriceList implements prices.Manager
+func (f *PriceManager) EditProductToPriceList(ctx context.Context, filters map[string]string) (*prices.ChangeProductToPriceListResult, error) {
+	var r0 *prices.ChangeProductToPriceListResult
+	if err := f.record("EditProductToPriceList", filters); err != nil {
+		return r0, err
+	}
+	if f.EditProductToPriceListFunc != nil {
+		return f.EditProductToPriceListFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// ChangeProductToPriceListBulk implements prices.Manager
+func (f *PriceManager) ChangeProductToPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (prices.ChangeProductToPriceListResponseBulk, error) {
+	var r0 prices.ChangeProductToPriceListResponseBulk
+	if err := f.record("ChangeProductToPriceListBulk", bulkRequest, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.ChangeProductToPriceListBulkFunc != nil {
+		return f.ChangeProductToPriceListBulkFunc(ctx, bulkRequest, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// DeleteProductsFromPriceList implements prices.Manager
+func (f *PriceManager) DeleteProductsFromPriceList(ctx context.Context, filters map[string]string) (*prices.DeleteProductsFromPriceListResult, error) {
+	var r0 *prices.DeleteProductsFromPriceListResult
+	if err := f.record("DeleteProductsFromPriceList", filters); err != nil {
+		return r0, err
+	}
+	if f.DeleteProductsFromPriceListFunc != nil {
+		return f.DeleteProductsFromPriceListFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// DeleteProductsFromPriceListBulk implements prices.Manager
+func (f *PriceManager) DeleteProductsFromPriceListBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (prices.DeleteProductsFromPriceListResponseBulk, error) {
+	var r0 prices.DeleteProductsFromPriceListResponseBulk
+	if err := f.record("DeleteProductsFromPriceListBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.DeleteProductsFromPriceListBulkFunc != nil {
+		return f.DeleteProductsFromPriceListBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
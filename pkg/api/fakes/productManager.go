@@ -0,0 +1,603 @@
+// Code generated by fakegen from products.Manager. DO NOT EDIT.
+
+package fakes
+
+import (
+	"context"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/products"
+)
+
+// ProductManager is a fake of products.Manager, see Fake for the behaviour of its methods
+type ProductManager struct {
+	*Fake
+	GetProductsFunc                  func(ctx context.Context, filters map[string]string) ([]products.Product, error)
+	GetProductsWithFiltersFunc       func(ctx context.Context, filters products.GetProductsFilters) ([]products.Product, error)
+	GetProductsCountFunc             func(ctx context.Context, filters map[string]string) (int, error)
+	GetProductsBulkFunc              func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductsResponseBulk, error)
+	GetProductUnitsFunc              func(ctx context.Context, filters map[string]string) ([]products.ProductUnit, error)
+	GetProductCategoriesFunc         func(ctx context.Context, filters map[string]string) ([]products.ProductCategory, error)
+	GetProductCategoriesBulkFunc     func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductCategoryResponseBulk, error)
+	GetProductBrandsFunc             func(ctx context.Context, filters map[string]string) ([]products.ProductBrand, error)
+	GetBrandsFunc                    func(ctx context.Context, filters map[string]string) ([]products.ProductBrand, error)
+	GetProductPriorityGroupsFunc     func(ctx context.Context, filters map[string]string) (products.GetProductPriorityGroups, error)
+	GetProductPriorityGroupBulkFunc  func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductPriorityGroupResponseBulk, error)
+	GetProductGroupsFunc             func(ctx context.Context, filters map[string]string) ([]products.ProductGroup, error)
+	GetProductGroupsBulkFunc         func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductGroupResponseBulk, error)
+	GetProductStockFunc              func(ctx context.Context, filters map[string]string) ([]products.GetProductStock, error)
+	GetProductStockFileFunc          func(ctx context.Context, filters map[string]string) ([]products.GetProductStockFile, error)
+	GetProductStockFileBulkFunc      func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductStockFileResponseBulk, error)
+	GetProductStockBulkFunc          func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductStockResponseBulk, error)
+	SaveProductFunc                  func(ctx context.Context, filters map[string]string) (products.SaveProductResult, error)
+	SaveProductWithInputFunc         func(ctx context.Context, input products.SaveProductInput) (products.SaveProductResult, error)
+	SaveProductBulkFunc              func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveProductResponseBulk, error)
+	DeleteProductFunc                func(ctx context.Context, filters map[string]string) error
+	DeleteProductBulkFunc            func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.DeleteProductResponseBulk, error)
+	SaveAssortmentFunc               func(ctx context.Context, filters map[string]string) (products.SaveAssortmentResult, error)
+	SaveAssortmentBulkFunc           func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveAssortmentResponseBulk, error)
+	AddAssortmentProductsFunc        func(ctx context.Context, filters map[string]string) (products.AddAssortmentProductsResult, error)
+	AddAssortmentProductsBulkFunc    func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.AddAssortmentProductsResponseBulk, error)
+	EditAssortmentProductsFunc       func(ctx context.Context, filters map[string]string) (products.EditAssortmentProductsResult, error)
+	EditAssortmentProductsBulkFunc   func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.EditAssortmentProductsResponseBulk, error)
+	RemoveAssortmentProductsFunc     func(ctx context.Context, filters map[string]string) (products.RemoveAssortmentProductResult, error)
+	RemoveAssortmentProductsBulkFunc func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.RemoveAssortmentProductResponseBulk, error)
+	SaveProductCategoryFunc          func(ctx context.Context, filters map[string]string) (products.SaveProductCategoryResult, error)
+	SaveProductCategoryBulkFunc      func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveProductCategoryResponseBulk, error)
+	SaveBrandFunc                    func(ctx context.Context, filters map[string]string) (products.SaveBrandResult, error)
+	SaveBrandBulkFunc                func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveBrandResponseBulk, error)
+	SaveProductPriorityGroupFunc     func(ctx context.Context, filters map[string]string) (products.SaveProductPriorityGroupResult, error)
+	SaveProductPriorityGroupBulkFunc func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveProductPriorityGroupResponseBulk, error)
+	SaveProductGroupFunc             func(ctx context.Context, filters map[string]string) (products.SaveProductGroupResult, error)
+	SaveProductGroupBulkFunc         func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveProductGroupResponseBulk, error)
+	DeleteProductGroupFunc           func(ctx context.Context, filters map[string]string) error
+	DeleteProductGroupBulkFunc       func(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.DeleteProductGroupResponseBulk, error)
+}
+
+var _ products.Manager = (*ProductManager)(nil)
+
+// NewProductManager creates a ProductManager with a new Fake
+func NewProductManager() *ProductManager {
+	return &ProductManager{Fake: NewFake()}
+}
+
+// GetProducts implements products.Manager
+func (f *ProductManager) GetProducts(ctx context.Context, filters map[string]string) ([]products.Product, error) {
+	var r0 []products.Product
+	if err := f.record("GetProducts", filters); err != nil {
+		return r0, err
+	}
+	if f.GetProductsFunc != nil {
+		return f.GetProductsFunc(ctx, filters)
+	}
+
+	err := f.handle("getProducts", filters, &r0)
+
+	return r0, err
+}
+
+// GetProductsWithFilters implements products.Manager
+func (f *ProductManager) GetProductsWithFilters(ctx context.Context, filters products.GetProductsFilters) ([]products.Product, error) {
+	var r0 []products.Product
+	if err := f.record("GetProductsWithFilters", filters); err != nil {
+		return r0, err
+	}
+	if f.GetProductsWithFiltersFunc != nil {
+		return f.GetProductsWithFiltersFunc(ctx, filters)
+	}
+
+	encoded, err := sharedCommon.EncodeFilters(filters)
+	if err != nil {
+		return r0, err
+	}
+
+	err = f.handle("getProducts", encoded, &r0)
+
+	return r0, err
+}
+
+// GetProductsCount implements products.Manager
+func (f *ProductManager) GetProductsCount(ctx context.Context, filters map[string]string) (int, error) {
+	var r0 int
+	if err := f.record("GetProductsCount", filters); err != nil {
+		return r0, err
+	}
+	if f.GetProductsCountFunc != nil {
+		return f.GetProductsCountFunc(ctx, filters)
+	}
+
+	return f.handleCount("getProducts", filters)
+}
+
+// GetProductsBulk implements products.Manager
+func (f *ProductManager) GetProductsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductsResponseBulk, error) {
+	var r0 products.GetProductsResponseBulk
+	if err := f.record("GetProductsBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.GetProductsBulkFunc != nil {
+		return f.GetProductsBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	err := f.handleBulk("getProducts", bulkFilters, baseFilters, &r0)
+
+	return r0, err
+}
+
+// GetProductUnits implements products.Manager
+func (f *ProductManager) GetProductUnits(ctx context.Context, filters map[string]string) ([]products.ProductUnit, error) {
+	var r0 []products.ProductUnit
+	if err := f.record("GetProductUnits", filters); err != nil {
+		return r0, err
+	}
+	if f.GetProductUnitsFunc != nil {
+		return f.GetProductUnitsFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// GetProductCategories implements products.Manager
+func (f *ProductManager) GetProductCategories(ctx context.Context, filters map[string]string) ([]products.ProductCategory, error) {
+	var r0 []products.ProductCategory
+	if err := f.record("GetProductCategories", filters); err != nil {
+		return r0, err
+	}
+	if f.GetProductCategoriesFunc != nil {
+		return f.GetProductCategoriesFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// GetProductCategoriesBulk implements products.Manager
+func (f *ProductManager) GetProductCategoriesBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductCategoryResponseBulk, error) {
+	var r0 products.GetProductCategoryResponseBulk
+	if err := f.record("GetProductCategoriesBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.GetProductCategoriesBulkFunc != nil {
+		return f.GetProductCategoriesBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// GetProductBrands implements products.Manager
+func (f *ProductManager) GetProductBrands(ctx context.Context, filters map[string]string) ([]products.ProductBrand, error) {
+	var r0 []products.ProductBrand
+	if err := f.record("GetProductBrands", filters); err != nil {
+		return r0, err
+	}
+	if f.GetProductBrandsFunc != nil {
+		return f.GetProductBrandsFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// GetBrands implements products.Manager
+func (f *ProductManager) GetBrands(ctx context.Context, filters map[string]string) ([]products.ProductBrand, error) {
+	var r0 []products.ProductBrand
+	if err := f.record("GetBrands", filters); err != nil {
+		return r0, err
+	}
+	if f.GetBrandsFunc != nil {
+		return f.GetBrandsFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// GetProductPriorityGroups implements products.Manager
+func (f *ProductManager) GetProductPriorityGroups(ctx context.Context, filters map[string]string) (products.GetProductPriorityGroups, error) {
+	var r0 products.GetProductPriorityGroups
+	if err := f.record("GetProductPriorityGroups", filters); err != nil {
+		return r0, err
+	}
+	if f.GetProductPriorityGroupsFunc != nil {
+		return f.GetProductPriorityGroupsFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// GetProductPriorityGroupBulk implements products.Manager
+func (f *ProductManager) GetProductPriorityGroupBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductPriorityGroupResponseBulk, error) {
+	var r0 products.GetProductPriorityGroupResponseBulk
+	if err := f.record("GetProductPriorityGroupBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.GetProductPriorityGroupBulkFunc != nil {
+		return f.GetProductPriorityGroupBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// GetProductGroups implements products.Manager
+func (f *ProductManager) GetProductGroups(ctx context.Context, filters map[string]string) ([]products.ProductGroup, error) {
+	var r0 []products.ProductGroup
+	if err := f.record("GetProductGroups", filters); err != nil {
+		return r0, err
+	}
+	if f.GetProductGroupsFunc != nil {
+		return f.GetProductGroupsFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// GetProductGroupsBulk implements products.Manager
+func (f *ProductManager) GetProductGroupsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductGroupResponseBulk, error) {
+	var r0 products.GetProductGroupResponseBulk
+	if err := f.record("GetProductGroupsBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.GetProductGroupsBulkFunc != nil {
+		return f.GetProductGroupsBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// GetProductStock implements products.Manager
+func (f *ProductManager) GetProductStock(ctx context.Context, filters map[string]string) ([]products.GetProductStock, error) {
+	var r0 []products.GetProductStock
+	if err := f.record("GetProductStock", filters); err != nil {
+		return r0, err
+	}
+	if f.GetProductStockFunc != nil {
+		return f.GetProductStockFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// GetProductStockFile implements products.Manager
+func (f *ProductManager) GetProductStockFile(ctx context.Context, filters map[string]string) ([]products.GetProductStockFile, error) {
+	var r0 []products.GetProductStockFile
+	if err := f.record("GetProductStockFile", filters); err != nil {
+		return r0, err
+	}
+	if f.GetProductStockFileFunc != nil {
+		return f.GetProductStockFileFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// GetProductStockFileBulk implements products.Manager
+func (f *ProductManager) GetProductStockFileBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductStockFileResponseBulk, error) {
+	var r0 products.GetProductStockFileResponseBulk
+	if err := f.record("GetProductStockFileBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.GetProductStockFileBulkFunc != nil {
+		return f.GetProductStockFileBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// GetProductStockBulk implements products.Manager
+func (f *ProductManager) GetProductStockBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.GetProductStockResponseBulk, error) {
+	var r0 products.GetProductStockResponseBulk
+	if err := f.record("GetProductStockBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.GetProductStockBulkFunc != nil {
+		return f.GetProductStockBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// SaveProduct implements products.Manager
+func (f *ProductManager) SaveProduct(ctx context.Context, filters map[string]string) (products.SaveProductResult, error) {
+	var r0 products.SaveProductResult
+	if err := f.record("SaveProduct", filters); err != nil {
+		return r0, err
+	}
+	if f.SaveProductFunc != nil {
+		return f.SaveProductFunc(ctx, filters)
+	}
+
+	err := f.handle("saveProduct", filters, &r0)
+
+	return r0, err
+}
+
+// SaveProductWithInput implements products.Manager
+func (f *ProductManager) SaveProductWithInput(ctx context.Context, input products.SaveProductInput) (products.SaveProductResult, error) {
+	var r0 products.SaveProductResult
+	if err := f.record("SaveProductWithInput", input); err != nil {
+		return r0, err
+	}
+	if f.SaveProductWithInputFunc != nil {
+		return f.SaveProductWithInputFunc(ctx, input)
+	}
+
+	encoded, err := sharedCommon.EncodeFilters(input)
+	if err != nil {
+		return r0, err
+	}
+
+	err = f.handle("saveProduct", encoded, &r0)
+
+	return r0, err
+}
+
+// SaveProductBulk implements products.Manager
+func (f *ProductManager) SaveProductBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveProductResponseBulk, error) {
+	var r0 products.SaveProductResponseBulk
+	if err := f.record("SaveProductBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.SaveProductBulkFunc != nil {
+		return f.SaveProductBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	err := f.handleBulk("saveProduct", bulkFilters, baseFilters, &r0)
+
+	return r0, err
+}
+
+// DeleteProduct implements products.Manager
+func (f *ProductManager) DeleteProduct(ctx context.Context, filters map[string]string) error {
+	if err := f.record("DeleteProduct", filters); err != nil {
+		return err
+	}
+	if f.DeleteProductFunc != nil {
+		return f.DeleteProductFunc(ctx, filters)
+	}
+
+	return f.handle("deleteProduct", filters, nil)
+}
+
+// DeleteProductBulk implements products.Manager
+func (f *ProductManager) DeleteProductBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.DeleteProductResponseBulk, error) {
+	var r0 products.DeleteProductResponseBulk
+	if err := f.record("DeleteProductBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.DeleteProductBulkFunc != nil {
+		return f.DeleteProductBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	err := f.handleBulk("deleteProduct", bulkFilters, baseFilters, &r0)
+
+	return r0, err
+}
+
+// SaveAssortment implements products.Manager
+func (f *ProductManager) SaveAssortment(ctx context.Context, filters map[string]string) (products.SaveAssortmentResult, error) {
+	var r0 products.SaveAssortmentResult
+	if err := f.record("SaveAssortment", filters); err != nil {
+		return r0, err
+	}
+	if f.SaveAssortmentFunc != nil {
+		return f.SaveAssortmentFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// SaveAssortmentBulk implements products.Manager
+func (f *ProductManager) SaveAssortmentBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveAssortmentResponseBulk, error) {
+	var r0 products.SaveAssortmentResponseBulk
+	if err := f.record("SaveAssortmentBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.SaveAssortmentBulkFunc != nil {
+		return f.SaveAssortmentBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// AddAssortmentProducts implements products.Manager
+func (f *ProductManager) AddAssortmentProducts(ctx context.Context, filters map[string]string) (products.AddAssortmentProductsResult, error) {
+	var r0 products.AddAssortmentProductsResult
+	if err := f.record("AddAssortmentProducts", filters); err != nil {
+		return r0, err
+	}
+	if f.AddAssortmentProductsFunc != nil {
+		return f.AddAssortmentProductsFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// AddAssortmentProductsBulk implements products.Manager
+func (f *ProductManager) AddAssortmentProductsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.AddAssortmentProductsResponseBulk, error) {
+	var r0 products.AddAssortmentProductsResponseBulk
+	if err := f.record("AddAssortmentProductsBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.AddAssortmentProductsBulkFunc != nil {
+		return f.AddAssortmentProductsBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// EditAssortmentProducts implements products.Manager
+func (f *ProductManager) EditAssortmentProducts(ctx context.Context, filters map[string]string) (products.EditAssortmentProductsResult, error) {
+	var r0 products.EditAssortmentProductsResult
+	if err := f.record("EditAssortmentProducts", filters); err != nil {
+		return r0, err
+	}
+	if f.EditAssortmentProductsFunc != nil {
+		return f.EditAssortmentProductsFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// EditAssortmentProductsBulk implements products.Manager
+func (f *ProductManager) EditAssortmentProductsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.EditAssortmentProductsResponseBulk, error) {
+	var r0 products.EditAssortmentProductsResponseBulk
+	if err := f.record("EditAssortmentProductsBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.EditAssortmentProductsBulkFunc != nil {
+		return f.EditAssortmentProductsBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// RemoveAssortmentProducts implements products.Manager
+func (f *ProductManager) RemoveAssortmentProducts(ctx context.Context, filters map[string]string) (products.RemoveAssortmentProductResult, error) {
+	var r0 products.RemoveAssortmentProductResult
+	if err := f.record("RemoveAssortmentProducts", filters); err != nil {
+		return r0, err
+	}
+	if f.RemoveAssortmentProductsFunc != nil {
+		return f.RemoveAssortmentProductsFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// RemoveAssortmentProductsBulk implements products.Manager
+func (f *ProductManager) RemoveAssortmentProductsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.RemoveAssortmentProductResponseBulk, error) {
+	var r0 products.RemoveAssortmentProductResponseBulk
+	if err := f.record("RemoveAssortmentProductsBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.RemoveAssortmentProductsBulkFunc != nil {
+		return f.RemoveAssortmentProductsBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// SaveProductCategory implements products.Manager
+func (f *ProductManager) SaveProductCategory(ctx context.Context, filters map[string]string) (products.SaveProductCategoryResult, error) {
+	var r0 products.SaveProductCategoryResult
+	if err := f.record("SaveProductCategory", filters); err != nil {
+		return r0, err
+	}
+	if f.SaveProductCategoryFunc != nil {
+		return f.SaveProductCategoryFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// SaveProductCategoryBulk implements products.Manager
+func (f *ProductManager) SaveProductCategoryBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveProductCategoryResponseBulk, error) {
+	var r0 products.SaveProductCategoryResponseBulk
+	if err := f.record("SaveProductCategoryBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.SaveProductCategoryBulkFunc != nil {
+		return f.SaveProductCategoryBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// SaveBrand implements products.Manager
+func (f *ProductManager) SaveBrand(ctx context.Context, filters map[string]string) (products.SaveBrandResult, error) {
+	var r0 products.SaveBrandResult
+	if err := f.record("SaveBrand", filters); err != nil {
+		return r0, err
+	}
+	if f.SaveBrandFunc != nil {
+		return f.SaveBrandFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// SaveBrandBulk implements products.Manager
+func (f *ProductManager) SaveBrandBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveBrandResponseBulk, error) {
+	var r0 products.SaveBrandResponseBulk
+	if err := f.record("SaveBrandBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.SaveBrandBulkFunc != nil {
+		return f.SaveBrandBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// SaveProductPriorityGroup implements products.Manager
+func (f *ProductManager) SaveProductPriorityGroup(ctx context.Context, filters map[string]string) (products.SaveProductPriorityGroupResult, error) {
+	var r0 products.SaveProductPriorityGroupResult
+	if err := f.record("SaveProductPriorityGroup", filters); err != nil {
+		return r0, err
+	}
+	if f.SaveProductPriorityGroupFunc != nil {
+		return f.SaveProductPriorityGroupFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// SaveProductPriorityGroupBulk implements products.Manager
+func (f *ProductManager) SaveProductPriorityGroupBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveProductPriorityGroupResponseBulk, error) {
+	var r0 products.SaveProductPriorityGroupResponseBulk
+	if err := f.record("SaveProductPriorityGroupBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.SaveProductPriorityGroupBulkFunc != nil {
+		return f.SaveProductPriorityGroupBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// SaveProductGroup implements products.Manager
+func (f *ProductManager) SaveProductGroup(ctx context.Context, filters map[string]string) (products.SaveProductGroupResult, error) {
+	var r0 products.SaveProductGroupResult
+	if err := f.record("SaveProductGroup", filters); err != nil {
+		return r0, err
+	}
+	if f.SaveProductGroupFunc != nil {
+		return f.SaveProductGroupFunc(ctx, filters)
+	}
+
+	return r0, nil
+}
+
+// SaveProductGroupBulk implements products.Manager
+func (f *ProductManager) SaveProductGroupBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.SaveProductGroupResponseBulk, error) {
+	var r0 products.SaveProductGroupResponseBulk
+	if err := f.record("SaveProductGroupBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.SaveProductGroupBulkFunc != nil {
+		return f.SaveProductGroupBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
+
+// DeleteProductGroup implements products.Manager
+func (f *ProductManager) DeleteProductGroup(ctx context.Context, filters map[string]string) error {
+	if err := f.record("DeleteProductGroup", filters); err != nil {
+		return err
+	}
+	if f.DeleteProductGroupFunc != nil {
+		return f.DeleteProductGroupFunc(ctx, filters)
+	}
+
+	return nil
+}
+
+// DeleteProductGroupBulk implements products.Manager
+func (f *ProductManager) DeleteProductGroupBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (products.DeleteProductGroupResponseBulk, error) {
+	var r0 products.DeleteProductGroupResponseBulk
+	if err := f.record("DeleteProductGroupBulk", bulkFilters, baseFilters); err != nil {
+		return r0, err
+	}
+	if f.DeleteProductGroupBulkFunc != nil {
+		return f.DeleteProductGroupBulkFunc(ctx, bulkFilters, baseFilters)
+	}
+
+	return r0, nil
+}
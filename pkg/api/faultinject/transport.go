@@ -0,0 +1,364 @@
+//Package faultinject provides an http.RoundTripper which injects ERPLY API failures into the requests of a client,
+//give it to ClientBuilder.HttpCli to test how the code using the SDK copes with them
+package faultinject
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+//Fault describes a failure and the requests which get it
+type Fault struct {
+	//Method is the ERPLY request name the fault applies to, an empty Method matches all requests and
+	//sharedCommon.BulkRequestMethod matches all bulk requests. A request name also matches the bulk requests
+	//with such sub-requests
+	Method string
+	//Probability of the fault for a matching request from 0 to 1, 0 means that every matching request gets it
+	Probability float64
+	//AfterCalls skips the fault for the first matching requests, e.g. to expire the session in the middle of a listing
+	AfterCalls int
+	//MaxTimes limits how many times the fault is injected, 0 means no limit
+	MaxTimes int
+
+	//Latency delays the request
+	Latency time.Duration
+	//Err fails the request with the transport error
+	Err error
+	//HTTPStatus gives a response with the status code and an empty body instead of sending the request
+	HTTPStatus int
+	//ErrorCode gives an API error response instead of sending the request, in bulk requests the fault which
+	//matches sub-requests by Method fails only these sub-requests after the request is sent
+	ErrorCode sharedCommon.ApiError
+	//TruncateBody cuts the response body in half, so it's not valid JSON
+	TruncateBody bool
+}
+
+//Transport injects the faults into the requests sent by Base, it's safe for concurrent use
+type Transport struct {
+	//Base sends the requests, http.DefaultTransport is used if it's nil
+	Base http.RoundTripper
+	//Faults are checked for each request in the given order, the effects of all applied faults are combined
+	Faults []Fault
+	//Seed makes the probabilities of the faults reproducible, the current time is used if it's 0
+	Seed int64
+
+	lock           sync.Mutex
+	random         *rand.Rand
+	matchedCounts  []int
+	injectedCounts []int
+}
+
+//NewTransport creates a Transport which injects the faults into the requests sent by base
+func NewTransport(base http.RoundTripper, faults ...Fault) *Transport {
+	return &Transport{Base: base, Faults: faults}
+}
+
+//Client gives an http.Client which sends the requests through the transport
+func (t *Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+//InjectedCounts gives how many times each of the Faults was injected
+func (t *Transport) InjectedCounts() []int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	counts := make([]int, len(t.Faults))
+	copy(counts, t.injectedCounts)
+
+	return counts
+}
+
+type requestInfo struct {
+	name        string
+	subRequests []string
+}
+
+func (ri requestInfo) isBulk() bool {
+	return ri.name == sharedCommon.BulkRequestMethod
+}
+
+//RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	info, outReq, err := readRequestInfo(req)
+	if err != nil {
+		return nil, err
+	}
+
+	faults := t.pickFaults(info)
+
+	var latency time.Duration
+	for _, fault := range faults {
+		latency += fault.Latency
+	}
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+
+	truncateBody := false
+	subRequestCodes := map[string]sharedCommon.ApiError{}
+	for _, fault := range faults {
+		switch {
+		case fault.Err != nil:
+			return nil, fault.Err
+		case fault.HTTPStatus != 0:
+			return newResponse(req, fault.HTTPStatus, ""), nil
+		case fault.ErrorCode != 0 && info.isBulk() && fault.Method != "" && fault.Method != sharedCommon.BulkRequestMethod:
+			subRequestCodes[fault.Method] = fault.ErrorCode
+		case fault.ErrorCode != 0:
+			return newErrorResponse(req, info, fault.ErrorCode)
+		}
+		truncateBody = truncateBody || fault.TruncateBody
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(outReq)
+	if err != nil || (len(subRequestCodes) == 0 && !truncateBody) {
+		return resp, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(subRequestCodes) > 0 {
+		body, err = failSubRequests(body, subRequestCodes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if truncateBody {
+		body = body[:len(body)/2]
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+
+	return resp, nil
+}
+
+//pickFaults gives the faults which should be injected into the request and counts them
+func (t *Transport) pickFaults(info requestInfo) []Fault {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.random == nil {
+		seed := t.Seed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		t.random = rand.New(rand.NewSource(seed))
+	}
+	if len(t.matchedCounts) != len(t.Faults) {
+		t.matchedCounts = make([]int, len(t.Faults))
+		t.injectedCounts = make([]int, len(t.Faults))
+	}
+
+	var faults []Fault
+	for i, fault := range t.Faults {
+		if !fault.matches(info) {
+			continue
+		}
+
+		t.matchedCounts[i]++
+		if t.matchedCounts[i] <= fault.AfterCalls {
+			continue
+		}
+		if fault.MaxTimes > 0 && t.injectedCounts[i] >= fault.MaxTimes {
+			continue
+		}
+		if fault.Probability > 0 && t.random.Float64() >= fault.Probability {
+			continue
+		}
+
+		t.injectedCounts[i]++
+		faults = append(faults, fault)
+	}
+
+	return faults
+}
+
+func (f Fault) matches(info requestInfo) bool {
+	if f.Method == "" || f.Method == info.name {
+		return true
+	}
+
+	for _, subRequest := range info.subRequests {
+		if subRequest == f.Method {
+			return true
+		}
+	}
+
+	return false
+}
+
+//readRequestInfo extracts the request names from the URL and the form encoded body,
+//it also gives the request which should be sent further, see readBody
+func readRequestInfo(req *http.Request) (requestInfo, *http.Request, error) {
+	params := req.URL.Query()
+	body, outReq, err := readBody(req)
+	if err != nil {
+		return requestInfo{}, nil, fmt.Errorf("faultinject: failed to read request body: %w", err)
+	}
+	if body != nil {
+		bodyValues, err := url.ParseQuery(string(body))
+		if err == nil {
+			for key, values := range bodyValues {
+				params[key] = append(params[key], values...)
+			}
+		}
+	}
+
+	bulkRequests := params.Get("requests")
+	if bulkRequests == "" {
+		return requestInfo{name: params.Get("request")}, outReq, nil
+	}
+
+	var subRequests []struct {
+		RequestName string `json:"requestName"`
+	}
+	if err := json.Unmarshal([]byte(bulkRequests), &subRequests); err != nil {
+		return requestInfo{}, nil, fmt.Errorf("faultinject: failed to decode bulk requests: %w", err)
+	}
+
+	info := requestInfo{name: sharedCommon.BulkRequestMethod}
+	for _, subRequest := range subRequests {
+		info.subRequests = append(info.subRequests, subRequest.RequestName)
+	}
+
+	return info, outReq, nil
+}
+
+//readBody reads the request body without changing the request, a clone with the body from GetBody is read
+//and the original request is sent further, if GetBody isn't set the original body is consumed
+//and a clone carrying the read bytes is sent instead
+func readBody(req *http.Request) ([]byte, *http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, req, nil
+	}
+
+	if req.GetBody != nil {
+		clone := req.Clone(req.Context())
+		var err error
+		clone.Body, err = req.GetBody()
+		if err != nil {
+			return nil, nil, err
+		}
+		defer clone.Body.Close()
+
+		body, err := ioutil.ReadAll(clone.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return body, req, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outReq := req.Clone(req.Context())
+	outReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+	outReq.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return body, outReq, nil
+}
+
+func newResponse(req *http.Request, statusCode int, body string) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+//newErrorResponse gives the response of a request which the API has rejected with the error code
+func newErrorResponse(req *http.Request, info requestInfo, code sharedCommon.ApiError) (*http.Response, error) {
+	status := map[string]interface{}{
+		"requestUnixTime": time.Now().Unix(),
+		"responseStatus":  "error",
+		"errorCode":       code,
+	}
+
+	var body map[string]interface{}
+	if info.isBulk() {
+		body = map[string]interface{}{"status": status, "requests": []interface{}{}}
+	} else {
+		status["request"] = info.name
+		body = map[string]interface{}{"status": status, "records": []interface{}{}}
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return newResponse(req, http.StatusOK, string(data)), nil
+}
+
+//failSubRequests sets the error codes to the statuses of the bulk sub-responses with the given request names
+func failSubRequests(body []byte, codes map[string]sharedCommon.ApiError) ([]byte, error) {
+	var bulkResp map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&bulkResp); err != nil {
+		return body, nil
+	}
+
+	subResponses, _ := bulkResp["requests"].([]interface{})
+	for _, subResponse := range subResponses {
+		subResponseMap, ok := subResponse.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		status, ok := subResponseMap["status"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		requestName, _ := status["requestName"].(string)
+		code, ok := codes[requestName]
+		if !ok {
+			continue
+		}
+
+		status["responseStatus"] = "error"
+		status["errorCode"] = code
+		subResponseMap["records"] = []interface{}{}
+	}
+
+	return json.Marshal(bulkResp)
+}
@@ -0,0 +1,185 @@
+package faultinject
+
+import (
+	"context"
+	"errors"
+	"github.com/erply/api-go-wrapper/pkg/api"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"github.com/erply/api-go-wrapper/pkg/api/fakeapi"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, faults ...Fault) (*api.Client, *Transport, *fakeapi.Server) {
+	srv := fakeapi.NewServer("123")
+	srv.AddSession("somesess")
+	_, err := srv.Insert(fakeapi.Products, map[string]interface{}{"name": "product", "code": "code"})
+	assert.NoError(t, err)
+
+	transport := NewTransport(http.DefaultTransport, faults...)
+	transport.Seed = 1
+
+	cli, err := api.NewClientWithURL("somesess", "123", "", srv.URL, transport.Client(), nil)
+	assert.NoError(t, err)
+
+	return cli, transport, srv
+}
+
+func TestErrorCodeFault(t *testing.T) {
+	cli, transport, srv := newTestClient(t, Fault{Method: "getProducts", ErrorCode: sharedCommon.HourlyRequestQuota})
+	defer srv.Close()
+	ctx := context.Background()
+
+	_, err := cli.ProductManager.GetProducts(ctx, map[string]string{})
+	erplyErr := &sharedCommon.ErplyError{}
+	if assert.True(t, errors.As(err, &erplyErr)) {
+		assert.Equal(t, sharedCommon.HourlyRequestQuota, erplyErr.Code)
+	}
+	assert.True(t, errors.Is(err, sharedCommon.ErrQuota))
+
+	_, err = cli.WarehouseManager.GetWarehouses(ctx, map[string]string{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []int{1}, transport.InjectedCounts())
+	assert.Equal(t, 0, srv.RequestCounts()["getProducts"])
+}
+
+func TestSessionExpiryAfterCalls(t *testing.T) {
+	srv := fakeapi.NewServer("123")
+	defer srv.Close()
+	srv.AddUser("user", "pass")
+
+	transport := NewTransport(http.DefaultTransport, Fault{
+		Method:     "getProducts",
+		ErrorCode:  sharedCommon.APISessionExpired,
+		AfterCalls: 2,
+		MaxTimes:   1,
+	})
+	cli := api.ClientBuilder{
+		ClientCode: "123",
+		UserName:   "user",
+		Password:   "pass",
+		URL:        srv.URL,
+		HttpCli:    transport.Client(),
+	}.Build()
+
+	for i := 0; i < 4; i++ {
+		_, err := cli.ProductManager.GetProducts(context.Background(), map[string]string{})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []int{1}, transport.InjectedCounts())
+	counts := srv.RequestCounts()
+	assert.Equal(t, 2, counts["verifyUser"])
+	assert.Equal(t, 4, counts["getProducts"])
+}
+
+func TestBulkSubRequestFault(t *testing.T) {
+	cli, _, srv := newTestClient(t, Fault{Method: "getProducts", ErrorCode: sharedCommon.ServerMaintenance})
+	defer srv.Close()
+
+	resp, err := cli.ProductManager.GetProductsBulk(
+		context.Background(),
+		[]map[string]interface{}{{"productID": 1}},
+		map[string]string{},
+	)
+	bulkErr := &sharedCommon.BulkError{}
+	assert.True(t, errors.As(err, &bulkErr))
+	if assert.Len(t, resp.BulkItems, 1) {
+		assert.Equal(t, sharedCommon.ServerMaintenance, resp.BulkItems[0].Status.ErrorCode)
+		assert.Len(t, resp.BulkItems[0].Products, 0)
+	}
+	assert.Equal(t, "ok", resp.Status.ResponseStatus)
+	assert.Equal(t, 1, srv.RequestCounts()["getProducts"])
+}
+
+func TestTruncatedBodyAndHTTPStatus(t *testing.T) {
+	cli, transport, srv := newTestClient(
+		t,
+		Fault{Method: "getProducts", TruncateBody: true},
+		Fault{Method: "getWarehouses", HTTPStatus: http.StatusBadGateway},
+		Fault{Method: "getVatRates", Err: errors.New("connection reset")},
+	)
+	defer srv.Close()
+	ctx := context.Background()
+
+	_, err := cli.ProductManager.GetProducts(ctx, map[string]string{})
+	assert.Error(t, err)
+
+	_, err = cli.WarehouseManager.GetWarehouses(ctx, map[string]string{})
+	assert.Error(t, err)
+
+	_, err = cli.SalesManager.GetVatRates(ctx, map[string]string{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "connection reset")
+	}
+
+	assert.Equal(t, []int{1, 1, 1}, transport.InjectedCounts())
+}
+
+func TestLatencyAndProbability(t *testing.T) {
+	cli, transport, srv := newTestClient(
+		t,
+		Fault{Method: "getProducts", Latency: 50 * time.Millisecond},
+		Fault{Method: "getWarehouses", Probability: 0.5, ErrorCode: sharedCommon.ServerMaintenance},
+	)
+	defer srv.Close()
+
+	started := time.Now()
+	_, err := cli.ProductManager.GetProducts(context.Background(), map[string]string{})
+	assert.NoError(t, err)
+	assert.True(t, time.Since(started) >= 50*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = cli.ProductManager.GetProducts(ctx, map[string]string{})
+	assert.Error(t, err)
+
+	failures := 0
+	for i := 0; i < 100; i++ {
+		if _, err := cli.WarehouseManager.GetWarehouses(context.Background(), map[string]string{}); err != nil {
+			failures++
+		}
+	}
+	assert.True(t, failures > 20 && failures < 80, "failures: %d", failures)
+	assert.Equal(t, failures, transport.InjectedCounts()[1])
+}
+
+func TestRequestIsLeftUntouched(t *testing.T) {
+	receivedBodies := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		receivedBodies = append(receivedBodies, string(body))
+		_, _ = w.Write([]byte(`{"status":{"responseStatus":"ok"},"records":[]}`))
+	}))
+	defer srv.Close()
+
+	transport := &Transport{Faults: []Fault{{Method: "getCustomers", ErrorCode: sharedCommon.APISessionExpired}}}
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader("request=getProducts&clientCode=123"))
+	assert.NoError(t, err)
+	body := req.Body
+
+	resp, err := transport.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.True(t, body == req.Body)
+
+	req, err = http.NewRequest(http.MethodPost, srv.URL, strings.NewReader("request=getProducts&clientCode=456"))
+	assert.NoError(t, err)
+	req.GetBody = nil
+	body = req.Body
+
+	_, err = transport.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.True(t, body == req.Body)
+
+	assert.Equal(t, []string{"request=getProducts&clientCode=123", "request=getProducts&clientCode=456"}, receivedBodies)
+	assert.Equal(t, []int{0}, transport.InjectedCounts())
+}
@@ -1,9 +1,11 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/erply/api-go-wrapper/internal/common"
 	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
 	"net/http"
 	"net/url"
@@ -36,12 +38,11 @@ func CreateInstallation(baseUrl, partnerKey string, filters map[string]string, h
 	params.Add("request", createInstallationMethod)
 	params.Add("partnerKey", partnerKey)
 
-	req, err := http.NewRequest("POST", baseUrl, nil)
+	req, err := common.NewPostRequest(context.Background(), baseUrl, params)
 	if err != nil {
 		return nil, sharedCommon.NewFromError("failed to build HTTP request", err, 0)
 
 	}
-	req.URL.RawQuery = params.Encode()
 	resp, err := httpCli.Do(req)
 	if err != nil {
 		return nil, sharedCommon.NewFromError("CreateInstallation: error sending POST request", err, 0)
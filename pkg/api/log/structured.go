@@ -0,0 +1,161 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+)
+
+//The keys of the fields which are attached to the log entries of the API clients
+const (
+	FieldClientCode = "clientCode"
+	FieldMethod     = "method"
+	FieldDuration   = "duration"
+	FieldStatus     = "status"
+	FieldErrorCode  = "errorCode"
+	FieldError      = "error"
+)
+
+//Field is a key/value pair of a structured log entry
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+//F is a shortcut for creating a Field
+func F(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+//StructuredLogger writes leveled messages with key/value fields, it can be set per client in the ClientBuilder
+type StructuredLogger interface {
+	LogFields(t Type, message string, fields ...Field)
+}
+
+//NopLogger discards all entries
+type NopLogger struct{}
+
+func (nl NopLogger) LogFields(t Type, message string, fields ...Field) {}
+
+//GlobalLogger forwards the entries to the global Log with the fields appended to the message,
+//it's used by the clients without their own logger, so setting the global Log keeps working as before
+type GlobalLogger struct{}
+
+func (gl GlobalLogger) LogFields(t Type, message string, fields ...Field) {
+	if Log == nil {
+		return
+	}
+	Log.Log(t, "%s", formatEntry(message, fields))
+}
+
+//StdStructuredLogger writes the entries starting from MinLevel to a standard library logger in the
+//`level=debug msg="..." key=value` form, the standard logger of the log package is used if Logger is nil
+type StdStructuredLogger struct {
+	Logger   *log.Logger
+	MinLevel Type
+}
+
+//NewStdStructuredLogger gives a StdStructuredLogger which writes the entries starting from minLevel to the logger
+func NewStdStructuredLogger(logger *log.Logger, minLevel Type) *StdStructuredLogger {
+	return &StdStructuredLogger{Logger: logger, MinLevel: minLevel}
+}
+
+func (sl *StdStructuredLogger) LogFields(t Type, message string, fields ...Field) {
+	if t < sl.MinLevel {
+		return
+	}
+
+	entry := fmt.Sprintf("level=%s msg=%s", t, formatValue(message))
+	if len(fields) > 0 {
+		entry += " " + formatFields(fields)
+	}
+
+	if sl.Logger == nil {
+		log.Print(entry)
+		return
+	}
+	sl.Logger.Print(entry)
+}
+
+//With gives a logger which adds the fields to every entry
+func With(logger StructuredLogger, fields ...Field) StructuredLogger {
+	return fieldsLogger{logger: logger, fields: fields}
+}
+
+type fieldsLogger struct {
+	logger StructuredLogger
+	fields []Field
+}
+
+func (fl fieldsLogger) LogFields(t Type, message string, fields ...Field) {
+	allFields := make([]Field, 0, len(fl.fields)+len(fields))
+	allFields = append(allFields, fl.fields...)
+	allFields = append(allFields, fields...)
+
+	fl.logger.LogFields(t, message, allFields...)
+}
+
+type contextFieldsKey struct{}
+
+//ContextWithFields gives a context which carries the fields, the clients add them to the log entries of the requests
+//made with it, e.g. to attach a request ID
+func ContextWithFields(ctx context.Context, fields ...Field) context.Context {
+	existing := FieldsFromContext(ctx)
+	allFields := make([]Field, 0, len(existing)+len(fields))
+	allFields = append(allFields, existing...)
+	allFields = append(allFields, fields...)
+
+	return context.WithValue(ctx, contextFieldsKey{}, allFields)
+}
+
+//FieldsFromContext gives the fields added with ContextWithFields
+func FieldsFromContext(ctx context.Context) []Field {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(contextFieldsKey{}).([]Field)
+
+	return fields
+}
+
+func (t Type) String() string {
+	switch t {
+	case Debug:
+		return "debug"
+	case Info:
+		return "info"
+	case Warn:
+		return "warn"
+	case Error:
+		return "error"
+	}
+
+	return fmt.Sprintf("level%d", int(t))
+}
+
+func formatEntry(message string, fields []Field) string {
+	if len(fields) == 0 {
+		return message
+	}
+
+	return message + " " + formatFields(fields)
+}
+
+func formatFields(fields []Field) string {
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		parts = append(parts, field.Key+"="+formatValue(field.Value))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func formatValue(value interface{}) string {
+	str := fmt.Sprint(value)
+	if str == "" || strings.ContainsAny(str, " \t\n\"=") {
+		return fmt.Sprintf("%q", str)
+	}
+
+	return str
+}
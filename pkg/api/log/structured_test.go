@@ -0,0 +1,55 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"log"
+	"testing"
+)
+
+func TestStdStructuredLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewStdStructuredLogger(log.New(buf, "", 0), Info)
+
+	logger.LogFields(Debug, "skipped")
+	logger.LogFields(Warn, "request finished", F(FieldMethod, "getProducts"), F(FieldErrorCode, 1002), F(FieldError, "some error"))
+
+	assert.Equal(t, "level=warn msg=\"request finished\" method=getProducts errorCode=1002 error=\"some error\"\n", buf.String())
+}
+
+type recordingLogger struct {
+	messages []string
+}
+
+func (rl *recordingLogger) Log(t Type, message string, arguments ...interface{}) {
+	rl.messages = append(rl.messages, fmt.Sprintf(message, arguments...))
+}
+
+func TestWithAndContextFields(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := With(NewStdStructuredLogger(log.New(buf, "", 0), Debug), F(FieldClientCode, "123"))
+
+	ctx := ContextWithFields(context.Background(), F("requestID", "a"))
+	ctx = ContextWithFields(ctx, F("userID", "b"))
+	logger.LogFields(Debug, "msg", FieldsFromContext(ctx)...)
+
+	assert.Equal(t, "level=debug msg=msg clientCode=123 requestID=a userID=b\n", buf.String())
+	assert.Nil(t, FieldsFromContext(context.Background()))
+}
+
+func TestGlobalLogger(t *testing.T) {
+	oldLogger := Log
+	defer func() {
+		Log = oldLogger
+	}()
+
+	recLogger := &recordingLogger{}
+	Log = recLogger
+
+	GlobalLogger{}.LogFields(Info, "msg", F(FieldStatus, 200))
+	NopLogger{}.LogFields(Info, "nothing")
+
+	assert.Equal(t, []string{"msg status=200"}, recLogger.messages)
+}
@@ -40,12 +40,12 @@ type GetUserOperationsLogResponse struct {
 	OperationLogs []OperationLog `json:"records"`
 }
 type OperationLog struct {
-	LogID     int    `json:"logID"`
-	Username  string `json:"username"`
-	Timestamp uint64 `json:"timestamp"`
-	TableName string `json:"tableName"`
-	ItemID    int    `json:"itemID"`
-	Operation string `json:"operation"`
+	LogID     int                    `json:"logID"`
+	Username  string                 `json:"username"`
+	Timestamp sharedCommon.Timestamp `json:"timestamp"`
+	TableName string                 `json:"tableName"`
+	ItemID    int                    `json:"itemID"`
+	Operation string                 `json:"operation"`
 }
 
 type GetUserRightsResponse struct {
@@ -54,41 +54,41 @@ type GetUserRightsResponse struct {
 }
 
 type Country struct {
-	CountryId             uint   `json:"countryID"`
-	CountryName           string `json:"countryName"`
-	CountryCode           string `json:"countryCode"`
-	MemberOfEuropeanUnion byte   `json:"memberOfEuropeanUnion"`
-	LastModified          uint64 `json:"lastModified"`
-	Added                 uint64 `json:"added"`
+	CountryId             uint                   `json:"countryID"`
+	CountryName           string                 `json:"countryName"`
+	CountryCode           string                 `json:"countryCode"`
+	MemberOfEuropeanUnion byte                   `json:"memberOfEuropeanUnion"`
+	LastModified          sharedCommon.Timestamp `json:"lastModified"`
+	Added                 sharedCommon.Timestamp `json:"added"`
 }
 
 type Event struct {
-	EventID       string `json:"eventID"`
-	ID            string `json:"id"`
-	Description   string `json:"description"`
-	TypeID        string `json:"typeID"`
-	StartTime     string `json:"startTime"`
-	EndTime       string `json:"endTime"`
-	CustomerID    string `json:"customerID"`
-	ContactID     string `json:"contactID"`
-	ProjectID     string `json:"projectID"`
-	EmployeeID    string `json:"employeeID"`
-	SubmitterID   string `json:"submitterID"`
-	SupplierID    string `json:"supplierID"`
-	SupplierName  string `json:"supplierName"`
-	StatusID      string `json:"statusID"`
-	ResourceID    string `json:"resourceID"`
-	Notes         string `json:"notes"`
-	LastModified  string `json:"lastModified"`
-	ContactName   string `json:"contactName"`
-	CustomerName  string `json:"customerName"`
-	EmployeeName  string `json:"employeeName"`
-	SubmitterName string `json:"submitterName"`
-	ProjectName   string `json:"projectName"`
-	ResourceName  string `json:"resourceName"`
-	StatusName    string `json:"statusName"`
-	TypeName      string `json:"typeName"`
-	Completed     string `json:"completed"`
+	EventID       sharedCommon.FlexInt   `json:"eventID"`
+	ID            sharedCommon.FlexInt   `json:"id"`
+	Description   string                 `json:"description"`
+	TypeID        sharedCommon.FlexInt   `json:"typeID"`
+	StartTime     sharedCommon.Timestamp `json:"startTime"`
+	EndTime       sharedCommon.Timestamp `json:"endTime"`
+	CustomerID    sharedCommon.FlexInt   `json:"customerID"`
+	ContactID     sharedCommon.FlexInt   `json:"contactID"`
+	ProjectID     sharedCommon.FlexInt   `json:"projectID"`
+	EmployeeID    sharedCommon.FlexInt   `json:"employeeID"`
+	SubmitterID   sharedCommon.FlexInt   `json:"submitterID"`
+	SupplierID    sharedCommon.FlexInt   `json:"supplierID"`
+	SupplierName  string                 `json:"supplierName"`
+	StatusID      sharedCommon.FlexInt   `json:"statusID"`
+	ResourceID    sharedCommon.FlexInt   `json:"resourceID"`
+	Notes         string                 `json:"notes"`
+	LastModified  sharedCommon.Timestamp `json:"lastModified"`
+	ContactName   string                 `json:"contactName"`
+	CustomerName  string                 `json:"customerName"`
+	EmployeeName  string                 `json:"employeeName"`
+	SubmitterName string                 `json:"submitterName"`
+	ProjectName   string                 `json:"projectName"`
+	ResourceName  string                 `json:"resourceName"`
+	StatusName    string                 `json:"statusName"`
+	TypeName      string                 `json:"typeName"`
+	Completed     sharedCommon.FlexBool  `json:"completed"`
 }
 
 type GetEventsResponse struct {
@@ -99,7 +99,7 @@ type GetEventsResponse struct {
 type SaveEventResponse struct {
 	Status  sharedCommon.Status
 	Records []struct {
-		EventID int `json:"eventID"`
+		EventID sharedCommon.FlexInt `json:"eventID"`
 	} `json:"records"`
 }
 type Employee struct {
@@ -121,16 +121,16 @@ type Employee struct {
 	PointsOfSale           string                      `json:"pointsOfSale"`
 	ProductIDs             []EmployeeProduct           `json:"productIDs"`
 	Attributes             []sharedCommon.ObjAttribute `json:"attributes"`
-	LastModified           uint64                      `json:"lastModified"`
+	LastModified           sharedCommon.Timestamp      `json:"lastModified"`
 	LastModifiedByUserName string                      `json:"lastModifiedByUserName"`
 
 	// detail fileds
-	Skype        string `json:"skype"`
-	Birthday     string `json:"birthday"`
-	JobTitleID   uint   `json:"jobTitleID"`
-	JobTitleName string `json:"jobTitleName"`
-	Notes        string `json:"notes"`
-	Added        uint64 `json:"added"`
+	Skype        string                 `json:"skype"`
+	Birthday     sharedCommon.Date      `json:"birthday"`
+	JobTitleID   uint                   `json:"jobTitleID"`
+	JobTitleName string                 `json:"jobTitleName"`
+	Notes        string                 `json:"notes"`
+	Added        sharedCommon.Timestamp `json:"added"`
 }
 
 type GetEmployeesResponseBulkItem struct {
@@ -155,19 +155,19 @@ type EmployeeProduct struct {
 }
 
 type BusinessArea struct {
-	Id           uint   `json:"id"`
-	Name         string `json:"name"`
-	Added        uint64 `json:"added"`
-	LastModified uint64 `json:"lastModified"`
+	Id           uint                   `json:"id"`
+	Name         string                 `json:"name"`
+	Added        sharedCommon.Timestamp `json:"added"`
+	LastModified sharedCommon.Timestamp `json:"lastModified"`
 }
 
 type Currency struct {
-	CurrencyID   string `json:"currencyID"`
-	Code         string `json:"code"`
-	Name         string `json:"name"`
-	Default      string `json:"default"`
-	NameShort    string `json:"nameShort"`
-	NameFraction string `json:"nameFraction"`
-	Added        string `json:"added"`
-	LastModified string `json:"lastModified"`
+	CurrencyID   string                 `json:"currencyID"`
+	Code         string                 `json:"code"`
+	Name         string                 `json:"name"`
+	Default      string                 `json:"default"`
+	NameShort    string                 `json:"nameShort"`
+	NameFraction string                 `json:"nameFraction"`
+	Added        sharedCommon.Timestamp `json:"added"`
+	LastModified sharedCommon.Timestamp `json:"lastModified"`
 }
@@ -6,12 +6,12 @@ import (
 
 type (
 	PointOfSale struct {
-		PointOfSaleID uint   `json:"pointOfSaleID"`
-		Name          string `json:"name"`
-		WarehouseID   int    `json:"warehouseID"`
-		WarehouseName string `json:"warehouseName"`
-		Added         uint64 `json:"added"`
-		LastModified  uint64 `json:"lastModified"`
+		PointOfSaleID uint              `json:"pointOfSaleID"`
+		Name          string            `json:"name"`
+		WarehouseID   int               `json:"warehouseID"`
+		WarehouseName string            `json:"warehouseName"`
+		Added         common2.Timestamp `json:"added"`
+		LastModified  common2.Timestamp `json:"lastModified"`
 	}
 
 	GetPointsOfSaleResponse struct {
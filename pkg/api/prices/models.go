@@ -5,9 +5,9 @@ import (
 )
 
 type PriceListRule struct {
-	ProductID int     `json:"productID"`
-	Price     float32 `json:"price,string"`
-	Amount    int     `json:"amount"`
+	ProductID int                `json:"productID"`
+	Price     sharedCommon.Money `json:"price"`
+	Amount    int                `json:"amount"`
 }
 
 type PriceList struct {
@@ -15,11 +15,11 @@ type PriceList struct {
 	SupplierID             int                         `json:"supplierID"`
 	SupplierName           string                      `json:"supplierName"`
 	Name                   string                      `json:"name"`
-	ValidFrom              string                      `json:"startDate"`
-	ValidTo                string                      `json:"endDate"`
-	Active                 string                      `json:"active"`
-	AddedTimestamp         int                         `json:"added"`
-	LastModifiedTimestamp  int                         `json:"lastModified"`
+	ValidFrom              sharedCommon.Date           `json:"startDate"`
+	ValidTo                sharedCommon.Date           `json:"endDate"`
+	Active                 sharedCommon.FlexBool       `json:"active"`
+	AddedTimestamp         sharedCommon.Timestamp      `json:"added"`
+	LastModifiedTimestamp  sharedCommon.Timestamp      `json:"lastModified"`
 	AddedByUserName        string                      `json:"addedByUserName"`
 	LastModifiedByUserName string                      `json:"lastModifiedByUserName"`
 	Rules                  []PriceListRule             `json:"pricelistRules"`
@@ -27,15 +27,15 @@ type PriceList struct {
 }
 
 type ProductsInSupplierPriceList struct {
-	SupplierPriceListProductID int     `json:"supplierPriceListProductID"`
-	ProductID                  int     `json:"productID"`
-	Price                      float32 `json:"price"`
-	Amount                     int     `json:"amount"`
-	CountryID                  int     `json:"countryID"`
-	ProductSupplierCode        string  `json:"supplierCode"`
-	ImportCode                 string  `json:"importCode"`
-	MasterPackQuantity         int     `json:"masterPackQuantity"`
-	MinimumOrderQuantity       int     `json:"minimumOrderQuantity"`
+	SupplierPriceListProductID int                `json:"supplierPriceListProductID"`
+	ProductID                  int                `json:"productID"`
+	Price                      sharedCommon.Money `json:"price"`
+	Amount                     int                `json:"amount"`
+	CountryID                  int                `json:"countryID"`
+	ProductSupplierCode        string             `json:"supplierCode"`
+	ImportCode                 string             `json:"importCode"`
+	MasterPackQuantity         int                `json:"masterPackQuantity"`
+	MinimumOrderQuantity       int                `json:"minimumOrderQuantity"`
 }
 
 type GetPriceListsResponseBulkItem struct {
@@ -49,14 +49,14 @@ type GetPriceListsResponseBulk struct {
 }
 
 type ProductsInPriceList struct {
-	PriceListProductID int     `json:"priceListProductID"`
-	ProductID          int     `json:"productID"`
-	Price              float32 `json:"price"`
-	Amount             int     `json:"amount"`
-	Subsidy            float32 `json:"subsidy"`
-	SubsidyTypeID      int     `json:"subsidyTypeID"`
-	Page               int     `json:"page"`
-	ForecastUnits      int     `json:"forecastUnits"`
+	PriceListProductID int                `json:"priceListProductID"`
+	ProductID          int                `json:"productID"`
+	Price              sharedCommon.Money `json:"price"`
+	Amount             int                `json:"amount"`
+	Subsidy            float32            `json:"subsidy"`
+	SubsidyTypeID      int                `json:"subsidyTypeID"`
+	Page               int                `json:"page"`
+	ForecastUnits      int                `json:"forecastUnits"`
 }
 
 type GetProductsInPriceListResponseBulkItem struct {
@@ -22,7 +22,7 @@ func (cli *Client) GetSupplierPriceLists(ctx context.Context, filters map[string
 	}
 
 	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, fmt.Errorf("ERPLY API: failed to unmarshal GetPriceListsResponse from '%s': %v", string(body), err)
+		return nil, fmt.Errorf("ERPLY API: failed to unmarshal GetPriceListsResponse from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&res.Status) {
 		return nil, sharedCommon.NewFromResponseStatus(&res.Status)
@@ -51,7 +51,7 @@ func (cli *Client) persistProductToSupplierPriceList(ctx context.Context, method
 
 	res := &ChangeProductToSupplierPriceListResponse{}
 	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, fmt.Errorf("ERPLY API: failed to unmarshal ChangeProductToSupplierPriceListResponse from '%s': %v", string(body), err)
+		return nil, fmt.Errorf("ERPLY API: failed to unmarshal ChangeProductToSupplierPriceListResponse from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&res.Status) {
@@ -69,10 +69,6 @@ func (cli *Client) persistProductToSupplierPriceList(ctx context.Context, method
 func (cli *Client) ChangeProductToSupplierPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (ChangeProductToSupplierPriceListResponseBulk, error) {
 	var bulkResp ChangeProductToSupplierPriceListResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot add more than %d products to price list in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, prodPrice := range bulkRequest {
 		_, isEditMode := prodPrice["supplierPriceListProductID"]
@@ -97,24 +93,16 @@ func (cli *Client) ChangeProductToSupplierPriceListBulk(ctx context.Context, bul
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal ChangeProductToSupplierPriceListResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal ChangeProductToSupplierPriceListResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) GetSupplierPriceListsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (GetPriceListsResponseBulk, error) {
@@ -137,19 +125,15 @@ func (cli *Client) GetSupplierPriceListsBulk(ctx context.Context, bulkFilters []
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetPriceListsResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetPriceListsResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, prodBulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, bulkResp.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) GetProductsInSupplierPriceList(ctx context.Context, filters map[string]string) ([]ProductsInSupplierPriceList, error) {
@@ -165,7 +149,7 @@ func (cli *Client) GetProductsInSupplierPriceList(ctx context.Context, filters m
 	}
 
 	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, fmt.Errorf("ERPLY API: failed to unmarshal ProductsInSupplierPriceListResponse from '%s': %v", string(body), err)
+		return nil, fmt.Errorf("ERPLY API: failed to unmarshal ProductsInSupplierPriceListResponse from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&res.Status) {
 		return nil, sharedCommon.NewFromResponseStatus(&res.Status)
@@ -194,19 +178,15 @@ func (cli *Client) GetProductsInSupplierPriceListBulk(ctx context.Context, bulkF
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal ProductsInSupplierPriceListResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal ProductsInSupplierPriceListResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, prodBulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, bulkResp.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) GetProductsInPriceList(ctx context.Context, filters map[string]string) ([]ProductsInPriceList, error) {
@@ -222,7 +202,7 @@ func (cli *Client) GetProductsInPriceList(ctx context.Context, filters map[strin
 	}
 
 	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, fmt.Errorf("ERPLY API: failed to unmarshal GetProductsInPriceListResponse from '%s': %v", string(body), err)
+		return nil, fmt.Errorf("ERPLY API: failed to unmarshal GetProductsInPriceListResponse from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&res.Status) {
 		return nil, sharedCommon.NewFromResponseStatus(&res.Status)
@@ -244,7 +224,7 @@ func (cli *Client) GetProductsInPriceListWithStatus(ctx context.Context, filters
 	}
 
 	if err := json.Unmarshal(body, &res); err != nil {
-		return res, fmt.Errorf("ERPLY API: failed to unmarshal GetProductsInPriceListResponse from '%s': %v", string(body), err)
+		return res, fmt.Errorf("ERPLY API: failed to unmarshal GetProductsInPriceListResponse from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&res.Status) {
 		return res, sharedCommon.NewFromResponseStatus(&res.Status)
@@ -253,7 +233,6 @@ func (cli *Client) GetProductsInPriceListWithStatus(ctx context.Context, filters
 	return res, nil
 }
 
-
 func (cli *Client) GetProductsInPriceListBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (GetProductsInPriceListResponseBulk, error) {
 	var bulkResp GetProductsInPriceListResponseBulk
 	bulkInputs := make([]common.BulkInput, 0, len(bulkFilters))
@@ -274,19 +253,15 @@ func (cli *Client) GetProductsInPriceListBulk(ctx context.Context, bulkFilters [
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetProductsInPriceListResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetProductsInPriceListResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, prodBulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, bulkResp.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) DeleteProductsFromSupplierPriceList(ctx context.Context, filters map[string]string) (*DeleteProductsFromSupplierPriceListResult, error) {
@@ -301,7 +276,7 @@ func (cli *Client) DeleteProductsFromSupplierPriceList(ctx context.Context, filt
 
 	res := &DeleteProductsFromSupplierPriceListResponse{}
 	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, fmt.Errorf("ERPLY API: failed to unmarshal DeleteProductsFromSupplierPriceListResponse from '%s': %v", string(body), err)
+		return nil, fmt.Errorf("ERPLY API: failed to unmarshal DeleteProductsFromSupplierPriceListResponse from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&res.Status) {
@@ -318,10 +293,6 @@ func (cli *Client) DeleteProductsFromSupplierPriceList(ctx context.Context, filt
 func (cli *Client) DeleteProductsFromSupplierPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (DeleteProductsFromSupplierPriceListResponseBulk, error) {
 	var bulkResp DeleteProductsFromSupplierPriceListResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot delete more than %d products from price list in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, bulkInput := range bulkRequest {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -341,24 +312,16 @@ func (cli *Client) DeleteProductsFromSupplierPriceListBulk(ctx context.Context,
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal DeleteProductsFromSupplierPriceListResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal DeleteProductsFromSupplierPriceListResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SaveSupplierPriceList(ctx context.Context, filters map[string]string) (*SaveSupplierPriceListResult, error) {
@@ -373,7 +336,7 @@ func (cli *Client) SaveSupplierPriceList(ctx context.Context, filters map[string
 
 	res := &SaveSupplierPriceListResultResponse{}
 	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, fmt.Errorf("ERPLY API: failed to unmarshal SaveSupplierPriceListResultResponse from '%s': %v", string(body), err)
+		return nil, fmt.Errorf("ERPLY API: failed to unmarshal SaveSupplierPriceListResultResponse from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&res.Status) {
@@ -390,10 +353,6 @@ func (cli *Client) SaveSupplierPriceList(ctx context.Context, filters map[string
 func (cli *Client) SaveSupplierPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (SaveSupplierPriceListResponseBulk, error) {
 	var bulkResp SaveSupplierPriceListResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot save more than %d price lists in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, bulkInput := range bulkRequest {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -413,24 +372,16 @@ func (cli *Client) SaveSupplierPriceListBulk(ctx context.Context, bulkRequest []
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal SaveSupplierPriceListResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal SaveSupplierPriceListResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SavePriceList(ctx context.Context, filters map[string]string) (*SavePriceListResult, error) {
@@ -445,7 +396,7 @@ func (cli *Client) SavePriceList(ctx context.Context, filters map[string]string)
 
 	res := &SavePriceListResultResponse{}
 	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, fmt.Errorf("ERPLY API: failed to unmarshal SavePriceListResultResponse from '%s': %v", string(body), err)
+		return nil, fmt.Errorf("ERPLY API: failed to unmarshal SavePriceListResultResponse from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&res.Status) {
@@ -462,10 +413,6 @@ func (cli *Client) SavePriceList(ctx context.Context, filters map[string]string)
 func (cli *Client) SavePriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (SavePriceListResponseBulk, error) {
 	var bulkResp SavePriceListResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot save more than %d price lists in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, bulkInput := range bulkRequest {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -485,24 +432,16 @@ func (cli *Client) SavePriceListBulk(ctx context.Context, bulkRequest []map[stri
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal SavePriceListResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal SavePriceListResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) AddProductToPriceList(ctx context.Context, filters map[string]string) (*ChangeProductToPriceListResult, error) {
@@ -525,7 +464,7 @@ func (cli *Client) persistProductToPriceList(ctx context.Context, method string,
 
 	res := &ChangeProductToPriceListResponse{}
 	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, fmt.Errorf("ERPLY API: failed to unmarshal ChangeProductToPriceListResponse from '%s': %v", string(body), err)
+		return nil, fmt.Errorf("ERPLY API: failed to unmarshal ChangeProductToPriceListResponse from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&res.Status) {
@@ -542,10 +481,6 @@ func (cli *Client) persistProductToPriceList(ctx context.Context, method string,
 func (cli *Client) ChangeProductToPriceListBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (ChangeProductToPriceListResponseBulk, error) {
 	var bulkResp ChangeProductToPriceListResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot add more than %d products to price list in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, prodPrice := range bulkRequest {
 		_, isEditMode := prodPrice["priceListProductID"]
@@ -570,24 +505,16 @@ func (cli *Client) ChangeProductToPriceListBulk(ctx context.Context, bulkRequest
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal ChangeProductToPriceListBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal ChangeProductToPriceListBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) DeleteProductsFromPriceList(ctx context.Context, filters map[string]string) (*DeleteProductsFromPriceListResult, error) {
@@ -602,7 +529,7 @@ func (cli *Client) DeleteProductsFromPriceList(ctx context.Context, filters map[
 
 	res := &DeleteProductsFromPriceListResponse{}
 	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, fmt.Errorf("ERPLY API: failed to unmarshal DeleteProductsFromPriceListResponse from '%s': %v", string(body), err)
+		return nil, fmt.Errorf("ERPLY API: failed to unmarshal DeleteProductsFromPriceListResponse from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&res.Status) {
@@ -616,17 +543,12 @@ func (cli *Client) DeleteProductsFromPriceList(ctx context.Context, filters map[
 	return &res.DeleteProductsFromPriceListResults[0], nil
 }
 
-
 func (cli *Client) DeleteProductsFromPriceListBulk(
 	ctx context.Context,
 	bulkRequest []map[string]interface{}, baseFilters map[string]string,
 ) (DeleteProductsFromPriceListResponseBulk, error) {
 	var bulkResp DeleteProductsFromPriceListResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot delete more than %d products from price list in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, bulkInput := range bulkRequest {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -646,22 +568,14 @@ func (cli *Client) DeleteProductsFromPriceListBulk(
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal DeleteProductsFromPriceListResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal DeleteProductsFromPriceListResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
@@ -140,7 +140,7 @@ func TestAddProductToSupplierPriceList(t *testing.T) {
 func TestEditProductToSupplierPriceList(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "someclient", r.URL.Query().Get("clientCode"))
-		assert.Equal(t, "somesess", r.URL.Query().Get("sessionKey"))
+		assert.Equal(t, "somesess", r.PostFormValue("sessionKey"))
 		assert.Equal(t, "editProductInSupplierPriceList", r.URL.Query().Get("request"))
 		assert.Equal(t, "1234", r.URL.Query().Get("supplierPriceListProductID"))
 		assert.Equal(t, "20.23", r.URL.Query().Get("price"))
@@ -407,7 +407,7 @@ func TestGetProductSupplierPriceListsBulk(t *testing.T) {
 					ProductsInSupplierPriceList: []ProductsInSupplierPriceList{
 						{
 							SupplierPriceListProductID: 123,
-							Price:                      100,
+							Price:                      sharedCommon.MustNewMoneyFromInt(100),
 						},
 					},
 				},
@@ -416,7 +416,7 @@ func TestGetProductSupplierPriceListsBulk(t *testing.T) {
 					ProductsInSupplierPriceList: []ProductsInSupplierPriceList{
 						{
 							SupplierPriceListProductID: 124,
-							Price:                      200,
+							Price:                      sharedCommon.MustNewMoneyFromInt(200),
 						},
 					},
 				},
@@ -459,7 +459,7 @@ func TestGetProductSupplierPriceListsBulk(t *testing.T) {
 	assert.Equal(t, []ProductsInSupplierPriceList{
 		{
 			SupplierPriceListProductID: 123,
-			Price:                      100,
+			Price:                      sharedCommon.MustNewMoneyFromInt(100),
 		},
 	}, bulkResp.BulkItems[0].ProductsInSupplierPriceList)
 
@@ -468,7 +468,7 @@ func TestGetProductSupplierPriceListsBulk(t *testing.T) {
 	assert.Equal(t, []ProductsInSupplierPriceList{
 		{
 			SupplierPriceListProductID: 124,
-			Price:                      200,
+			Price:                      sharedCommon.MustNewMoneyFromInt(200),
 		},
 	}, bulkResp.BulkItems[1].ProductsInSupplierPriceList)
 	assert.Equal(t, expectedStatus, bulkResp.BulkItems[1].Status)
@@ -486,7 +486,7 @@ func TestGetProductPriceListsBulk(t *testing.T) {
 					PriceLists: []ProductsInPriceList{
 						{
 							PriceListProductID: 123,
-							Price:              100,
+							Price:              sharedCommon.MustNewMoneyFromInt(100),
 						},
 					},
 				},
@@ -495,7 +495,7 @@ func TestGetProductPriceListsBulk(t *testing.T) {
 					PriceLists: []ProductsInPriceList{
 						{
 							PriceListProductID: 124,
-							Price:              200,
+							Price:              sharedCommon.MustNewMoneyFromInt(200),
 						},
 					},
 				},
@@ -538,7 +538,7 @@ func TestGetProductPriceListsBulk(t *testing.T) {
 	assert.Equal(t, []ProductsInPriceList{
 		{
 			PriceListProductID: 123,
-			Price:              100,
+			Price:              sharedCommon.MustNewMoneyFromInt(100),
 		},
 	}, bulkResp.BulkItems[0].PriceLists)
 
@@ -547,7 +547,7 @@ func TestGetProductPriceListsBulk(t *testing.T) {
 	assert.Equal(t, []ProductsInPriceList{
 		{
 			PriceListProductID: 124,
-			Price:              200,
+			Price:              sharedCommon.MustNewMoneyFromInt(200),
 		},
 	}, bulkResp.BulkItems[1].PriceLists)
 	assert.Equal(t, expectedStatus, bulkResp.BulkItems[1].Status)
@@ -562,11 +562,11 @@ func TestGetProductSupplierPriceLists(t *testing.T) {
 			ProductsInSupplierPriceList: []ProductsInSupplierPriceList{
 				{
 					SupplierPriceListProductID: 123,
-					Price:                      100,
+					Price:                      sharedCommon.MustNewMoneyFromInt(100),
 				},
 				{
 					SupplierPriceListProductID: 124,
-					Price:                      200,
+					Price:                      sharedCommon.MustNewMoneyFromInt(200),
 				},
 			},
 		}
@@ -596,11 +596,11 @@ func TestGetProductSupplierPriceLists(t *testing.T) {
 	assert.Equal(t, []ProductsInSupplierPriceList{
 		{
 			SupplierPriceListProductID: 123,
-			Price:                      100,
+			Price:                      sharedCommon.MustNewMoneyFromInt(100),
 		},
 		{
 			SupplierPriceListProductID: 124,
-			Price:                      200,
+			Price:                      sharedCommon.MustNewMoneyFromInt(200),
 		},
 	}, actualProductPriceItems)
 }
@@ -614,11 +614,11 @@ func TestGetProductPriceLists(t *testing.T) {
 			PriceLists: []ProductsInPriceList{
 				{
 					PriceListProductID: 123,
-					Price:              100,
+					Price:              sharedCommon.MustNewMoneyFromInt(100),
 				},
 				{
 					PriceListProductID: 124,
-					Price:              200,
+					Price:              sharedCommon.MustNewMoneyFromInt(200),
 				},
 			},
 		}
@@ -648,11 +648,11 @@ func TestGetProductPriceLists(t *testing.T) {
 	assert.Equal(t, []ProductsInPriceList{
 		{
 			PriceListProductID: 123,
-			Price:              100,
+			Price:              sharedCommon.MustNewMoneyFromInt(100),
 		},
 		{
 			PriceListProductID: 124,
-			Price:              200,
+			Price:              sharedCommon.MustNewMoneyFromInt(200),
 		},
 	}, actualProductPriceItems)
 }
@@ -712,7 +712,7 @@ func TestGetProductPriceListsResponseFailure(t *testing.T) {
 func TestDeleteProductsFromSupplierPriceList(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "someclient", r.URL.Query().Get("clientCode"))
-		assert.Equal(t, "somesess", r.URL.Query().Get("sessionKey"))
+		assert.Equal(t, "somesess", r.PostFormValue("sessionKey"))
 		assert.Equal(t, "deleteProductsFromSupplierPriceList", r.URL.Query().Get("request"))
 		assert.Equal(t, "2223", r.URL.Query().Get("supplierPriceListID"))
 		assert.Equal(t, "3444,3445", r.URL.Query().Get("supplierPriceListProductIDs"))
@@ -848,7 +848,7 @@ func TestDeleteProductsFromSupplierPriceListBulk(t *testing.T) {
 func TestSaveSupplierPriceList(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "someclient", r.URL.Query().Get("clientCode"))
-		assert.Equal(t, "somesess", r.URL.Query().Get("sessionKey"))
+		assert.Equal(t, "somesess", r.PostFormValue("sessionKey"))
 		assert.Equal(t, "saveSupplierPriceList", r.URL.Query().Get("request"))
 		assert.Equal(t, "Some Price Name 1", r.URL.Query().Get("name"))
 		assert.Equal(t, "34456", r.URL.Query().Get("supplierID"))
@@ -1001,7 +1001,7 @@ func TestSaveSupplierPriceListBulk(t *testing.T) {
 func TestSavePriceList(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "someclient", r.URL.Query().Get("clientCode"))
-		assert.Equal(t, "somesess", r.URL.Query().Get("sessionKey"))
+		assert.Equal(t, "somesess", r.PostFormValue("sessionKey"))
 		assert.Equal(t, "savePriceList", r.URL.Query().Get("request"))
 		assert.Equal(t, "Some Price Name 1", r.URL.Query().Get("name"))
 		assert.Equal(t, "34456", r.URL.Query().Get("pricelistID"))
@@ -1283,7 +1283,7 @@ func TestChangeProductInPriceListBulk(t *testing.T) {
 func TestAddProductToPriceList(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "someclient", r.URL.Query().Get("clientCode"))
-		assert.Equal(t, "somesess", r.URL.Query().Get("sessionKey"))
+		assert.Equal(t, "somesess", r.PostFormValue("sessionKey"))
 		assert.Equal(t, "addProductToPriceList", r.URL.Query().Get("request"))
 		assert.Equal(t, "3333", r.URL.Query().Get("priceListID"))
 		assert.Equal(t, "342314", r.URL.Query().Get("productID"))
@@ -1325,7 +1325,7 @@ func TestAddProductToPriceList(t *testing.T) {
 func TestEditProductInPriceList(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "someclient", r.URL.Query().Get("clientCode"))
-		assert.Equal(t, "somesess", r.URL.Query().Get("sessionKey"))
+		assert.Equal(t, "somesess", r.PostFormValue("sessionKey"))
 		assert.Equal(t, "editProductInPriceList", r.URL.Query().Get("request"))
 		assert.Equal(t, "1234", r.URL.Query().Get("priceListProductID"))
 		assert.Equal(t, "20.23", r.URL.Query().Get("price"))
@@ -1369,7 +1369,7 @@ func TestEditProductInPriceList(t *testing.T) {
 func TestDeleteProductsFromPriceList(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "someclient", r.URL.Query().Get("clientCode"))
-		assert.Equal(t, "somesess", r.URL.Query().Get("sessionKey"))
+		assert.Equal(t, "somesess", r.PostFormValue("sessionKey"))
 		assert.Equal(t, "deleteProductInPriceList", r.URL.Query().Get("request"))
 		assert.Equal(t, "2223", r.URL.Query().Get("priceListID"))
 		assert.Equal(t, "3444,3445", r.URL.Query().Get("priceListProductIDs"))
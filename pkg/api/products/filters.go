@@ -0,0 +1,45 @@
+package products
+
+import (
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"time"
+)
+
+//GetProductsFilters are the typed filters of getProducts
+type GetProductsFilters struct {
+	ProductID               int       `json:"productID"`
+	ProductIDs              []int     `json:"productIDs"`
+	Type                    string    `json:"type"`
+	Code                    string    `json:"code"`
+	Code2                   string    `json:"code2"`
+	Code3                   string    `json:"code3"`
+	SupplierCode            string    `json:"supplierCode"`
+	Name                    string    `json:"name"`
+	SearchAttributeName     string    `json:"searchAttributeName"`
+	SearchAttributeValue    string    `json:"searchAttributeValue"`
+	GroupID                 int       `json:"groupID"`
+	CategoryID              int       `json:"categoryID"`
+	BrandID                 int       `json:"brandID"`
+	SupplierID              int       `json:"supplierID"`
+	PriorityGroupID         int       `json:"priorityGroupID"`
+	Active                  *bool     `json:"active"`
+	Status                  string    `json:"status"`
+	ChangedSince            time.Time `json:"changedSince"`
+	AddedSince              time.Time `json:"addedSince"`
+	WarehouseID             int       `json:"warehouseID"`
+	GetStockInfo            bool      `json:"getStockInfo"`
+	GetPriceListPrices      bool      `json:"getPriceListPrices"`
+	GetFIFOCost             bool      `json:"getFIFOCost"`
+	GetPackageInfo          bool      `json:"getPackageInfo"`
+	GetMatrixVariations     bool      `json:"getMatrixVariations"`
+	GetParameters           bool      `json:"getParameters"`
+	GetRelatedFiles         bool      `json:"getRelatedFiles"`
+	GetReplacementProducts  bool      `json:"getReplacementProducts"`
+	GetContainerInfo        bool      `json:"getContainerInfo"`
+	IncludeMatrixVariations bool      `json:"includeMatrixVariations"`
+	Lang                    string    `json:"lang"`
+	GetAllLanguages         bool      `json:"getAllLanguages"`
+	OrderBy                 string    `json:"orderBy"`
+	OrderByDir              string    `json:"orderByDir"`
+	sharedCommon.Pagination
+}
@@ -0,0 +1,34 @@
+package products
+
+import (
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//SaveProductInput is the typed input of saveProduct, the product is updated if ProductID is set
+type SaveProductInput struct {
+	ProductID          int                         `json:"productID"`
+	Type               string                      `json:"type"`
+	GroupID            int                         `json:"groupID"`
+	UnitID             int                         `json:"unitID"`
+	Code               string                      `json:"code"`
+	Code2              string                      `json:"code2"`
+	Code3              string                      `json:"code3"`
+	SupplierCode       string                      `json:"supplierCode"`
+	Name               string                      `json:"name"`
+	NameEng            string                      `json:"nameENG"`
+	Description        string                      `json:"description"`
+	DescriptionLong    string                      `json:"longdesc"`
+	VatrateID          int                         `json:"vatrateID"`
+	Price              sharedCommon.Money          `json:"price"`
+	Cost               sharedCommon.Money          `json:"cost"`
+	NetWeight          float64                     `json:"netWeight"`
+	GrossWeight        float64                     `json:"grossWeight"`
+	Status             string                      `json:"status"`
+	Active             *bool                       `json:"active"`
+	DisplayedInWebshop *bool                       `json:"displayedInWebshop"`
+	CategoryID         int                         `json:"categoryID"`
+	BrandID            int                         `json:"brandID"`
+	SupplierID         int                         `json:"supplierID"`
+	PriorityGroupID    int                         `json:"priorityGroupID"`
+	Attributes         []sharedCommon.ObjAttribute `json:"attributes,indexed"`
+}
@@ -4,6 +4,7 @@ import "context"
 
 type Manager interface {
 	GetProducts(ctx context.Context, filters map[string]string) ([]Product, error)
+	GetProductsWithFilters(ctx context.Context, filters GetProductsFilters) ([]Product, error)
 	GetProductsCount(ctx context.Context, filters map[string]string) (int, error)
 	GetProductsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (GetProductsResponseBulk, error)
 	GetProductUnits(ctx context.Context, filters map[string]string) ([]ProductUnit, error)
@@ -32,6 +33,7 @@ type Manager interface {
 	GetProductStockFileBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (GetProductStockFileResponseBulk, error)
 	GetProductStockBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (GetProductStockResponseBulk, error)
 	SaveProduct(ctx context.Context, filters map[string]string) (SaveProductResult, error)
+	SaveProductWithInput(ctx context.Context, input SaveProductInput) (SaveProductResult, error)
 	SaveProductBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (SaveProductResponseBulk, error)
 	DeleteProduct(ctx context.Context, filters map[string]string) error
 	DeleteProductBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (DeleteProductResponseBulk, error)
@@ -2,15 +2,18 @@ package products
 
 import (
 	"context"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
 )
 
 type ProductCategoriesListingDataProvider struct {
 	erplyAPI Manager
+	sharedCommon.ListingClient
 }
 
 func NewProductCategoriesListingDataProvider(erplyClient Manager) *ProductCategoriesListingDataProvider {
 	return &ProductCategoriesListingDataProvider{
-		erplyAPI: erplyClient,
+		erplyAPI:      erplyClient,
+		ListingClient: sharedCommon.NewListingClient(erplyClient),
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"sort"
+	"sync"
 	"testing"
 	"time"
 )
@@ -306,3 +307,46 @@ mainLoop:
 
 	return actualProdIDs
 }
+
+type spanNamesTracer struct {
+	lock  sync.Mutex
+	names []string
+}
+
+func (snt *spanNamesTracer) StartSpan(ctx context.Context, name string, attributes ...sharedCommon.Attribute) (context.Context, sharedCommon.Span) {
+	snt.lock.Lock()
+	defer snt.lock.Unlock()
+
+	snt.names = append(snt.names, name)
+	_, span := sharedCommon.StartSpan(context.Background(), name)
+
+	return ctx, span
+}
+
+func TestListingTracerOfClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, sendRequest(w, 0, 1, [][]int{{1}}))
+	}))
+	defer srv.Close()
+
+	tracer := &spanNamesTracer{}
+	constr := &common.ClientConstructor{}
+	constr.WithSessionKey("somesess")
+	constr.WithClientCode("someclient")
+	constr.WithURL(srv.URL)
+	constr.WithTracer(tracer)
+	limiter := sharedCommon.NewTokenBucketLimiter(sharedCommon.RateLimits{PerSecond: 100})
+	constr.WithRateLimiter(limiter)
+
+	productsDataProvider := NewListingDataProvider(NewClient(constr.Build()))
+	assert.Equal(t, tracer, productsDataProvider.GetTracer())
+	assert.Equal(t, limiter, productsDataProvider.GetRateLimiter())
+
+	lister := sharedCommon.NewLister(sharedCommon.ListingSettings{}, productsDataProvider, func(time.Duration) {})
+	for item := range lister.Get(context.Background(), map[string]interface{}{}) {
+		assert.NoError(t, item.Err)
+	}
+
+	assert.Contains(t, tracer.names, sharedCommon.SpanListerPage)
+	assert.Contains(t, tracer.names, sharedCommon.SpanHTTPCall)
+}
@@ -1,7 +1,6 @@
 package products
 
 import (
-	"encoding/json"
 	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
 )
 
@@ -31,10 +30,10 @@ type (
 	}
 
 	ProductPriorityGroup struct {
-		PriorityGroupID   int    `json:"priorityGroupID"`
-		PriorityGroupName string `json:"priorityGroupName"`
-		Added             int64  `json:"added"`
-		LastModified      int64  `json:"lastModified"`
+		PriorityGroupID   int                    `json:"priorityGroupID"`
+		PriorityGroupName string                 `json:"priorityGroupName"`
+		Added             sharedCommon.Timestamp `json:"added"`
+		LastModified      sharedCommon.Timestamp `json:"lastModified"`
 	}
 
 	GetProductPriorityGroups struct {
@@ -96,12 +95,12 @@ type (
 	}
 
 	PriceCalculationStep struct {
-		PriceListID   int     `json:"priceListID"`
-		PriceListName string  `json:"priceListName"`
-		Price         float64 `json:"price"`
-		Discount      float64 `json:"discount"`
-		Type          string  `json:"type"`
-		Percentage    float64 `json:"percentage"`
+		PriceListID   int                `json:"priceListID"`
+		PriceListName string             `json:"priceListName"`
+		Price         sharedCommon.Money `json:"price"`
+		Discount      float64            `json:"discount"`
+		Type          string             `json:"type"`
+		Percentage    float64            `json:"percentage"`
 	}
 
 	//Payload ...
@@ -118,9 +117,9 @@ type (
 		Code7                        *string                `json:"code7"`
 		Code8                        *string                `json:"code8"`
 		GroupID                      uint                   `json:"groupID"`
-		Price                        float64                `json:"price"`
-		Cost                         float64                `json:"cost"`
-		FifoCost                     float64                `json:"FIFOCost"`
+		Price                        sharedCommon.Money     `json:"price"`
+		Cost                         sharedCommon.Money     `json:"cost"`
+		FifoCost                     sharedCommon.Money     `json:"FIFOCost"`
 		DisplayedInWebshop           byte                   `json:"displayedInWebshop"`
 		BrandID                      uint                   `json:"brandID"`
 		Description                  string                 `json:"description"`
@@ -147,15 +146,15 @@ type (
 		DescriptionLongGre           string                 `json:"longdescGRE"`
 		AddedByUsername              string                 `json:"addedByUsername"`
 		LastModifiedByUsername       string                 `json:"lastModifiedByUsername"`
-		Added                        uint64                 `json:"added"`
-		LastModified                 uint64                 `json:"lastModified"`
+		Added                        sharedCommon.Timestamp `json:"added"`
+		LastModified                 sharedCommon.Timestamp `json:"lastModified"`
 		VatrateID                    uint64                 `json:"vatrateID"`
 		Vatrate                      float64                `json:"vatrate"`
-		PriceWithVat                 float64                `json:"priceWithVat"`
-		BackbarCharges               float64                `json:"backbarCharges"`
-		PurchasePrice                float64                `json:"purchasePrice"`
-		PriceListPrice               float64                `json:"priceListPrice"`
-		PriceListPriceWithVat        float64                `json:"priceListPriceWithVat"`
+		PriceWithVat                 sharedCommon.Money     `json:"priceWithVat"`
+		BackbarCharges               sharedCommon.Money     `json:"backbarCharges"`
+		PurchasePrice                sharedCommon.Money     `json:"purchasePrice"`
+		PriceListPrice               sharedCommon.Money     `json:"priceListPrice"`
+		PriceListPriceWithVat        sharedCommon.Money     `json:"priceListPriceWithVat"`
 		GrossWeight                  string                 `json:"grossWeight"`
 		NetWeight                    string                 `json:"netWeight"`
 		UnitName                     *string                `json:"unitName"`
@@ -201,7 +200,7 @@ type (
 		DeliveryTime                 string                 `json:"deliveryTime"`
 		ContainerName                string                 `json:"containerName"`
 		ContainerCode                string                 `json:"containerCode"`
-		ContainerAmount              json.Number            `json:"containerAmount"`
+		ContainerAmount              sharedCommon.FlexFloat `json:"containerAmount"`
 		PackagingType                string                 `json:"packagingType"`
 		LocationInWarehouse          string                 `json:"locationInWarehouse"`
 		LocationInWarehouseName      string                 `json:"locationInWarehouseName"`
@@ -256,12 +255,15 @@ type (
 		PriceCalculationSteps        []PriceCalculationStep `json:"priceCalculationSteps"`
 		sharedCommon.Attributes
 		sharedCommon.LongAttributes
+
+		//Extra contains the fields which are not declared above, it's filled only if ClientBuilder.KeepExtraFields is enabled
+		Extra sharedCommon.ExtraFields `json:"-"`
 	}
 
 	Option struct {
-		ID              int     `json:"optionID"`
-		Name            string  `json:"optionName"`
-		AdditionalPrice float64 `json:"optionAdditionalPrice"`
+		ID              int                `json:"optionID"`
+		Name            string             `json:"optionName"`
+		AdditionalPrice sharedCommon.Money `json:"optionAdditionalPrice"`
 	}
 
 	Parameter struct {
@@ -274,15 +276,15 @@ type (
 	}
 
 	StockInfo struct {
-		WarehouseID   uint        `json:"warehouseID"`
-		Free          float64     `json:"free"`
-		OrderPending  int         `json:"orderPending"`
-		ReorderPoint  int         `json:"reorderPoint"`
-		Reserved      json.Number `json:"reserved"`
-		TotalInStock  json.Number `json:"totalInStock"`
-		RestockLevel  float64     `json:"restockLevel"`
-		FifoCost      float32     `json:"FIFOCost"`
-		PurchasePrice float32     `json:"purchasePrice"`
+		WarehouseID   uint                   `json:"warehouseID"`
+		Free          float64                `json:"free"`
+		OrderPending  int                    `json:"orderPending"`
+		ReorderPoint  int                    `json:"reorderPoint"`
+		Reserved      sharedCommon.FlexFloat `json:"reserved"`
+		TotalInStock  sharedCommon.FlexFloat `json:"totalInStock"`
+		RestockLevel  float64                `json:"restockLevel"`
+		FifoCost      sharedCommon.Money     `json:"FIFOCost"`
+		PurchasePrice sharedCommon.Money     `json:"purchasePrice"`
 	}
 
 	ProductImage struct {
@@ -299,19 +301,19 @@ type (
 	}
 
 	ProductCategory struct {
-		ProductCategoryID   int   `json:"productCategoryID"`
-		ParentCategoryID    int   `json:"parentCategoryID"`
-		ProductCategoryName string `json:"productCategoryName"`
-		Added               uint64 `json:"added"`
-		LastModified        uint64 `json:"lastModified"`
+		ProductCategoryID   int                    `json:"productCategoryID"`
+		ParentCategoryID    int                    `json:"parentCategoryID"`
+		ProductCategoryName string                 `json:"productCategoryName"`
+		Added               sharedCommon.Timestamp `json:"added"`
+		LastModified        sharedCommon.Timestamp `json:"lastModified"`
 		sharedCommon.Attributes
 	}
 
 	ProductBrand struct {
-		ID           uint   `json:"brandID"`
-		Name         string `json:"name"`
-		Added        uint64 `json:"added"`
-		LastModified uint64 `json:"lastModified"`
+		ID           uint                   `json:"brandID"`
+		Name         string                 `json:"name"`
+		Added        sharedCommon.Timestamp `json:"added"`
+		LastModified sharedCommon.Timestamp `json:"lastModified"`
 	}
 
 	VatRateRef struct {
@@ -322,13 +324,13 @@ type (
 	ProductGroup struct {
 		ID int `json:"productGroupID"`
 		NameLanguages
-		ShowInWebshop   string            `json:"showInWebshop"`
-		NonDiscountable int            `json:"nonDiscountable"`
-		PositionNo      int            `json:"positionNo"`
-		ParentGroupID   string            `json:"parentGroupID"`
-		Added           uint64         `json:"added"`
-		LastModified    uint64         `json:"lastModified"`
-		SubGroups       []ProductGroup `json:"subGroups"`
+		ShowInWebshop   string                 `json:"showInWebshop"`
+		NonDiscountable int                    `json:"nonDiscountable"`
+		PositionNo      int                    `json:"positionNo"`
+		ParentGroupID   string                 `json:"parentGroupID"`
+		Added           sharedCommon.Timestamp `json:"added"`
+		LastModified    sharedCommon.Timestamp `json:"lastModified"`
+		SubGroups       []ProductGroup         `json:"subGroups"`
 		sharedCommon.Attributes
 		Images      []ProductGroupImage `json:"images"`
 		VatRateRefs []VatRateRef        `json:"vatrates"`
@@ -382,17 +384,17 @@ type (
 	}
 
 	GetProductStock struct {
-		ProductID              int         `json:"productID"`
-		AmountInStock          json.Number `json:"amountInStock"`
-		AmountReserved         float64     `json:"amountReserved"`
-		SuggestedPurchasePrice float64     `json:"suggestedPurchasePrice"`
-		AveragePurchasePrice   float64     `json:"averagePurchasePrice"`
-		AverageCost            float64     `json:"averageCost"`
-		FirstPurchaseDate      string      `json:"firstPurchaseDate"`
-		LastPurchaseDate       string      `json:"lastPurchaseDate"`
-		LastSoldDate           string      `json:"lastSoldDate"`
-		ReorderPoint           int         `json:"reorderPoint"`
-		RestockLevel           float64     `json:"restockLevel"`
+		ProductID              int                    `json:"productID"`
+		AmountInStock          sharedCommon.FlexFloat `json:"amountInStock"`
+		AmountReserved         float64                `json:"amountReserved"`
+		SuggestedPurchasePrice sharedCommon.Money     `json:"suggestedPurchasePrice"`
+		AveragePurchasePrice   sharedCommon.Money     `json:"averagePurchasePrice"`
+		AverageCost            sharedCommon.Money     `json:"averageCost"`
+		FirstPurchaseDate      sharedCommon.Date      `json:"firstPurchaseDate"`
+		LastPurchaseDate       sharedCommon.Date      `json:"lastPurchaseDate"`
+		LastSoldDate           sharedCommon.Date      `json:"lastSoldDate"`
+		ReorderPoint           int                    `json:"reorderPoint"`
+		RestockLevel           float64                `json:"restockLevel"`
 	}
 
 	GetProductStockFileResponse struct {
@@ -33,16 +33,33 @@ func (cli *Client) GetProducts(ctx context.Context, filters map[string]string) (
 	if err != nil {
 		return nil, err
 	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, sharedCommon.NewFromError("failed to read GetProductsResponse", err, 0)
+	}
 	var res GetProductsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+	if err := json.Unmarshal(body, &res); err != nil {
 		return nil, sharedCommon.NewFromError("failed to unmarshal GetProductsResponse", err, 0)
 	}
 	if !common.IsJSONResponseOK(&res.Status) {
 		return nil, sharedCommon.NewFromResponseStatus(&res.Status)
 	}
+	if err := cli.DecodeExtraFields(body, res.Products); err != nil {
+		return nil, err
+	}
 	return res.Products, nil
 }
 
+//GetProductsWithFilters is GetProducts with the typed filters
+func (cli *Client) GetProductsWithFilters(ctx context.Context, filters GetProductsFilters) ([]Product, error) {
+	filtersMap, err := sharedCommon.EncodeFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli.GetProducts(ctx, filtersMap)
+}
+
 func (cli *Client) GetProductsCount(ctx context.Context, filters map[string]string) (int, error) {
 	resp, err := cli.SendRequest(ctx, "getProducts", filters)
 	if err != nil {
@@ -94,19 +111,21 @@ func (cli *Client) GetProductsBulk(ctx context.Context, bulkFilters []map[string
 	}
 
 	if err := json.Unmarshal(body, &productsResp); err != nil {
-		return productsResp, fmt.Errorf("ERPLY API: failed to unmarshal GetProductsResponseBulk from '%s': %v", string(body), err)
+		return productsResp, fmt.Errorf("ERPLY API: failed to unmarshal GetProductsResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&productsResp.Status) {
-		return productsResp, sharedCommon.NewErplyError(productsResp.Status.ErrorCode.String(), productsResp.Status.Request+": "+productsResp.Status.ResponseStatus, productsResp.Status.ErrorCode)
+		return productsResp, sharedCommon.NewFromResponseStatus(&productsResp.Status)
 	}
 
-	for _, prodBulkItem := range productsResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return productsResp, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, prodBulkItem.Status.ErrorCode)
-		}
+	if err := cli.DecodeBulkExtraFields(body, func(i int) interface{} {
+		return productsResp.BulkItems[i].Products
+	}); err != nil {
+		return productsResp, err
 	}
 
-	return productsResp, nil
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
+
+	return productsResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SaveProduct(ctx context.Context, filters map[string]string) (SaveProductResult, error) {
@@ -128,6 +147,16 @@ func (cli *Client) SaveProduct(ctx context.Context, filters map[string]string) (
 	return SaveProductResult{}, nil
 }
 
+//SaveProductWithInput is SaveProduct with the typed input
+func (cli *Client) SaveProductWithInput(ctx context.Context, input SaveProductInput) (SaveProductResult, error) {
+	filters, err := sharedCommon.EncodeFilters(input)
+	if err != nil {
+		return SaveProductResult{}, err
+	}
+
+	return cli.SaveProduct(ctx, filters)
+}
+
 func (cli *Client) SaveProductBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (SaveProductResponseBulk, error) {
 	var productsResp SaveProductResponseBulk
 	bulkInputs := make([]common.BulkInput, 0, len(bulkFilters))
@@ -148,19 +177,15 @@ func (cli *Client) SaveProductBulk(ctx context.Context, bulkFilters []map[string
 	}
 
 	if err := json.Unmarshal(body, &productsResp); err != nil {
-		return productsResp, fmt.Errorf("ERPLY API: failed to unmarshal SaveProductResponseBulk from '%s': %v", string(body), err)
+		return productsResp, fmt.Errorf("ERPLY API: failed to unmarshal SaveProductResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&productsResp.Status) {
-		return productsResp, sharedCommon.NewErplyError(productsResp.Status.ErrorCode.String(), productsResp.Status.Request+": "+productsResp.Status.ResponseStatus, productsResp.Status.ErrorCode)
+		return productsResp, sharedCommon.NewFromResponseStatus(&productsResp.Status)
 	}
 
-	for _, prodBulkItem := range productsResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return productsResp, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, productsResp.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return productsResp, nil
+	return productsResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) DeleteProduct(ctx context.Context, filters map[string]string) error {
@@ -198,19 +223,15 @@ func (cli *Client) DeleteProductBulk(ctx context.Context, bulkFilters []map[stri
 	}
 
 	if err := json.Unmarshal(body, &deleteRespBulk); err != nil {
-		return deleteRespBulk, fmt.Errorf("ERPLY API: failed to unmarshal DeleteProductResponseBulk from '%s': %v", string(body), err)
+		return deleteRespBulk, fmt.Errorf("ERPLY API: failed to unmarshal DeleteProductResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&deleteRespBulk.Status) {
-		return deleteRespBulk, sharedCommon.NewErplyError(deleteRespBulk.Status.ErrorCode.String(), deleteRespBulk.Status.Request+": "+deleteRespBulk.Status.ResponseStatus, deleteRespBulk.Status.ErrorCode)
+		return deleteRespBulk, sharedCommon.NewFromResponseStatus(&deleteRespBulk.Status)
 	}
 
-	for _, prodBulkItem := range deleteRespBulk.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return deleteRespBulk, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, deleteRespBulk.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return deleteRespBulk, nil
+	return deleteRespBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) GetProductCategories(ctx context.Context, filters map[string]string) ([]ProductCategory, error) {
@@ -325,19 +346,15 @@ func (cli *Client) GetProductStockBulk(ctx context.Context, bulkFilters []map[st
 	}
 
 	if err := json.Unmarshal(body, &productsStockResp); err != nil {
-		return productsStockResp, fmt.Errorf("ERPLY API: failed to unmarshal GetProductStockFileResponseBulk from '%s': %v", string(body), err)
+		return productsStockResp, fmt.Errorf("ERPLY API: failed to unmarshal GetProductStockFileResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&productsStockResp.Status) {
-		return productsStockResp, sharedCommon.NewErplyError(productsStockResp.Status.ErrorCode.String(), productsStockResp.Status.Request+": "+productsStockResp.Status.ResponseStatus, productsStockResp.Status.ErrorCode)
+		return productsStockResp, sharedCommon.NewFromResponseStatus(&productsStockResp.Status)
 	}
 
-	for _, prodBulkItem := range productsStockResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return productsStockResp, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, productsStockResp.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return productsStockResp, nil
+	return productsStockResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) GetProductStockFileBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (GetProductStockFileResponseBulk, error) {
@@ -361,27 +378,15 @@ func (cli *Client) GetProductStockFileBulk(ctx context.Context, bulkFilters []ma
 	}
 
 	if err := json.Unmarshal(body, &productsStockResp); err != nil {
-		return productsStockResp, fmt.Errorf("ERPLY API: failed to unmarshal GetProductStockFileResponseBulk from '%s': %v", string(body), err)
+		return productsStockResp, fmt.Errorf("ERPLY API: failed to unmarshal GetProductStockFileResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&productsStockResp.Status) {
-		return productsStockResp, sharedCommon.NewErplyError(
-			productsStockResp.Status.ErrorCode.String(),
-			productsStockResp.Status.Request+": "+productsStockResp.Status.ResponseStatus,
-			productsStockResp.Status.ErrorCode,
-		)
+		return productsStockResp, sharedCommon.NewFromResponseStatus(&productsStockResp.Status)
 	}
 
-	for _, prodBulkItem := range productsStockResp.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return productsStockResp, sharedCommon.NewErplyError(
-				prodBulkItem.Status.ErrorCode.String(),
-				prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus,
-				productsStockResp.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return productsStockResp, nil
+	return productsStockResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SaveAssortment(ctx context.Context, filters map[string]string) (SaveAssortmentResult, error) {
@@ -423,27 +428,15 @@ func (cli *Client) SaveAssortmentBulk(ctx context.Context, bulkFilters []map[str
 	}
 
 	if err := json.Unmarshal(body, &assortmentResp); err != nil {
-		return assortmentResp, fmt.Errorf("ERPLY API: failed to unmarshal SaveAssortmentResponseBulk from '%s': %v", string(body), err)
+		return assortmentResp, fmt.Errorf("ERPLY API: failed to unmarshal SaveAssortmentResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&assortmentResp.Status) {
-		return assortmentResp, sharedCommon.NewErplyError(
-			assortmentResp.Status.ErrorCode.String(),
-			assortmentResp.Status.Request+": "+assortmentResp.Status.ResponseStatus,
-			assortmentResp.Status.ErrorCode,
-		)
+		return assortmentResp, sharedCommon.NewFromResponseStatus(&assortmentResp.Status)
 	}
 
-	for _, assortmentItem := range assortmentResp.BulkItems {
-		if !common.IsJSONResponseOK(&assortmentItem.Status.Status) {
-			return assortmentResp, sharedCommon.NewErplyError(
-				assortmentItem.Status.ErrorCode.String(),
-				assortmentItem.Status.Request+": "+assortmentItem.Status.ResponseStatus,
-				assortmentResp.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return assortmentResp, nil
+	return assortmentResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) AddAssortmentProducts(ctx context.Context, filters map[string]string) (AddAssortmentProductsResult, error) {
@@ -485,27 +478,15 @@ func (cli *Client) AddAssortmentProductsBulk(ctx context.Context, bulkFilters []
 	}
 
 	if err := json.Unmarshal(body, &assortmentResp); err != nil {
-		return assortmentResp, fmt.Errorf("ERPLY API: failed to unmarshal AddAssortmentProductsResponseBulk from '%s': %v", string(body), err)
+		return assortmentResp, fmt.Errorf("ERPLY API: failed to unmarshal AddAssortmentProductsResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&assortmentResp.Status) {
-		return assortmentResp, sharedCommon.NewErplyError(
-			assortmentResp.Status.ErrorCode.String(),
-			assortmentResp.Status.Request+": "+assortmentResp.Status.ResponseStatus,
-			assortmentResp.Status.ErrorCode,
-		)
+		return assortmentResp, sharedCommon.NewFromResponseStatus(&assortmentResp.Status)
 	}
 
-	for _, assortmentItem := range assortmentResp.BulkItems {
-		if !common.IsJSONResponseOK(&assortmentItem.Status.Status) {
-			return assortmentResp, sharedCommon.NewErplyError(
-				assortmentItem.Status.ErrorCode.String(),
-				assortmentItem.Status.Request+": "+assortmentItem.Status.ResponseStatus,
-				assortmentItem.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return assortmentResp, nil
+	return assortmentResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) EditAssortmentProducts(ctx context.Context, filters map[string]string) (EditAssortmentProductsResult, error) {
@@ -547,27 +528,15 @@ func (cli *Client) EditAssortmentProductsBulk(ctx context.Context, bulkFilters [
 	}
 
 	if err := json.Unmarshal(body, &assortmentResp); err != nil {
-		return assortmentResp, fmt.Errorf("ERPLY API: failed to unmarshal EditAssortmentProductsResponseBulk from '%s': %v", string(body), err)
+		return assortmentResp, fmt.Errorf("ERPLY API: failed to unmarshal EditAssortmentProductsResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&assortmentResp.Status) {
-		return assortmentResp, sharedCommon.NewErplyError(
-			assortmentResp.Status.ErrorCode.String(),
-			assortmentResp.Status.Request+": "+assortmentResp.Status.ResponseStatus,
-			assortmentResp.Status.ErrorCode,
-		)
+		return assortmentResp, sharedCommon.NewFromResponseStatus(&assortmentResp.Status)
 	}
 
-	for _, assortmentItem := range assortmentResp.BulkItems {
-		if !common.IsJSONResponseOK(&assortmentItem.Status.Status) {
-			return assortmentResp, sharedCommon.NewErplyError(
-				assortmentItem.Status.ErrorCode.String(),
-				assortmentItem.Status.Request+": "+assortmentItem.Status.ResponseStatus,
-				assortmentItem.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return assortmentResp, nil
+	return assortmentResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) RemoveAssortmentProducts(ctx context.Context, filters map[string]string) (RemoveAssortmentProductResult, error) {
@@ -609,27 +578,15 @@ func (cli *Client) RemoveAssortmentProductsBulk(ctx context.Context, bulkFilters
 	}
 
 	if err := json.Unmarshal(body, &assortmentResp); err != nil {
-		return assortmentResp, fmt.Errorf("ERPLY API: failed to unmarshal RemoveAssortmentProductResponseBulk from '%s': %v", string(body), err)
+		return assortmentResp, fmt.Errorf("ERPLY API: failed to unmarshal RemoveAssortmentProductResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&assortmentResp.Status) {
-		return assortmentResp, sharedCommon.NewErplyError(
-			assortmentResp.Status.ErrorCode.String(),
-			assortmentResp.Status.Request+": "+assortmentResp.Status.ResponseStatus,
-			assortmentResp.Status.ErrorCode,
-		)
+		return assortmentResp, sharedCommon.NewFromResponseStatus(&assortmentResp.Status)
 	}
 
-	for _, assortmentItem := range assortmentResp.BulkItems {
-		if !common.IsJSONResponseOK(&assortmentItem.Status.Status) {
-			return assortmentResp, sharedCommon.NewErplyError(
-				assortmentItem.Status.ErrorCode.String(),
-				assortmentItem.Status.Request+": "+assortmentItem.Status.ResponseStatus,
-				assortmentResp.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return assortmentResp, nil
+	return assortmentResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SaveProductCategory(ctx context.Context, filters map[string]string) (result SaveProductCategoryResult, err error) {
@@ -674,27 +631,15 @@ func (cli *Client) SaveProductCategoryBulk(
 	}
 
 	if err := json.Unmarshal(body, &respBulk); err != nil {
-		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveProductCategoryResponseBulk from '%s': %v", string(body), err)
+		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveProductCategoryResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&respBulk.Status) {
-		return respBulk, sharedCommon.NewErplyError(
-			respBulk.Status.ErrorCode.String(),
-			respBulk.Status.Request+": "+respBulk.Status.ResponseStatus,
-			respBulk.Status.ErrorCode,
-		)
+		return respBulk, sharedCommon.NewFromResponseStatus(&respBulk.Status)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus,
-				respBulk.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return respBulk, nil
+	return respBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SaveBrand(ctx context.Context, filters map[string]string) (result SaveBrandResult, err error) {
@@ -739,19 +684,15 @@ func (cli *Client) SaveBrandBulk(
 	}
 
 	if err := json.Unmarshal(body, &respBulk); err != nil {
-		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveBrandResponseBulk from '%s': %v", string(body), err)
+		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveBrandResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&respBulk.Status) {
-		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
+		return respBulk, sharedCommon.NewFromResponseStatus(&respBulk.Status)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return respBulk, nil
+	return respBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SaveProductPriorityGroup(ctx context.Context, filters map[string]string) (result SaveProductPriorityGroupResult, err error) {
@@ -796,19 +737,15 @@ func (cli *Client) SaveProductPriorityGroupBulk(
 	}
 
 	if err := json.Unmarshal(body, &respBulk); err != nil {
-		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveProductPriorityGroupResponseBulk from '%s': %v", string(body), err)
+		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveProductPriorityGroupResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&respBulk.Status) {
-		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
+		return respBulk, sharedCommon.NewFromResponseStatus(&respBulk.Status)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return respBulk, nil
+	return respBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) GetProductPriorityGroupBulk(
@@ -836,19 +773,15 @@ func (cli *Client) GetProductPriorityGroupBulk(
 	bodyStr := string(body)
 
 	if err := json.Unmarshal(body, &respBulk); err != nil {
-		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal GetProductPriorityGroupResponseBulk from '%s': %v", bodyStr, err)
+		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal GetProductPriorityGroupResponseBulk from '%s': %v", sharedCommon.RedactText(bodyStr), err)
 	}
 	if !common.IsJSONResponseOK(&respBulk.Status) {
-		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
+		return respBulk, sharedCommon.NewFromResponseStatus(&respBulk.Status)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return respBulk, nil
+	return respBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) GetProductCategoriesBulk(
@@ -876,19 +809,15 @@ func (cli *Client) GetProductCategoriesBulk(
 	bodyStr := string(body)
 
 	if err := json.Unmarshal(body, &respBulk); err != nil {
-		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal GetProductCategoryResponseBulk from '%s': %v", bodyStr, err)
+		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal GetProductCategoryResponseBulk from '%s': %v", sharedCommon.RedactText(bodyStr), err)
 	}
 	if !common.IsJSONResponseOK(&respBulk.Status) {
-		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
+		return respBulk, sharedCommon.NewFromResponseStatus(&respBulk.Status)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return respBulk, nil
+	return respBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) GetProductGroupsBulk(
@@ -916,19 +845,15 @@ func (cli *Client) GetProductGroupsBulk(
 	bodyStr := string(body)
 
 	if err := json.Unmarshal(body, &respBulk); err != nil {
-		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal GetProductGroupResponseBulk from '%s': %v", bodyStr, err)
+		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal GetProductGroupResponseBulk from '%s': %v", sharedCommon.RedactText(bodyStr), err)
 	}
 	if !common.IsJSONResponseOK(&respBulk.Status) {
-		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
+		return respBulk, sharedCommon.NewFromResponseStatus(&respBulk.Status)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return respBulk, nil
+	return respBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SaveProductGroup(ctx context.Context, filters map[string]string) (result SaveProductGroupResult, err error) {
@@ -973,19 +898,15 @@ func (cli *Client) SaveProductGroupBulk(
 	}
 
 	if err := json.Unmarshal(body, &respBulk); err != nil {
-		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveProductGroupResponseBulk from '%s': %v", string(body), err)
+		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveProductGroupResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&respBulk.Status) {
-		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
+		return respBulk, sharedCommon.NewFromResponseStatus(&respBulk.Status)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return respBulk, nil
+	return respBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) DeleteProductGroup(ctx context.Context, filters map[string]string) error {
@@ -1023,17 +944,13 @@ func (cli *Client) DeleteProductGroupBulk(ctx context.Context, bulkFilters []map
 	}
 
 	if err := json.Unmarshal(body, &deleteRespBulk); err != nil {
-		return deleteRespBulk, fmt.Errorf("ERPLY API: failed to unmarshal DeleteProductGroupResponseBulk from '%s': %v", string(body), err)
+		return deleteRespBulk, fmt.Errorf("ERPLY API: failed to unmarshal DeleteProductGroupResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&deleteRespBulk.Status) {
-		return deleteRespBulk, sharedCommon.NewErplyError(deleteRespBulk.Status.ErrorCode.String(), deleteRespBulk.Status.Request+": "+deleteRespBulk.Status.ResponseStatus, deleteRespBulk.Status.ErrorCode)
+		return deleteRespBulk, sharedCommon.NewFromResponseStatus(&deleteRespBulk.Status)
 	}
 
-	for _, prodBulkItem := range deleteRespBulk.BulkItems {
-		if !common.IsJSONResponseOK(&prodBulkItem.Status.Status) {
-			return deleteRespBulk, sharedCommon.NewErplyError(prodBulkItem.Status.ErrorCode.String(), prodBulkItem.Status.Request+": "+prodBulkItem.Status.ResponseStatus, deleteRespBulk.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return deleteRespBulk, nil
+	return deleteRespBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
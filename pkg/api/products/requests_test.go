@@ -69,6 +69,89 @@ func TestProductManager(t *testing.T) {
 	})
 }
 
+func TestGetProductsExtraFields(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"status":{"responseStatus":"ok"},"records":[{"productID":1,"code":"abc","name":"Some","newField":{"a":1}}]}`))
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	cli := common.NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	prods, err := NewClient(cli).GetProducts(context.Background(), map[string]string{})
+	assert.NoError(t, err)
+	assert.Len(t, prods, 1)
+	assert.Nil(t, prods[0].Extra)
+
+	constr := &common.ClientConstructor{}
+	constr.WithSessionKey("somesess")
+	constr.WithClientCode("someclient")
+	constr.WithURL(srv.URL)
+	constr.WithKeepExtraFields(true)
+
+	prods, err = NewClient(constr.Build()).GetProducts(context.Background(), map[string]string{})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	assert.Len(t, prods, 1)
+	assert.Equal(t, 1, prods[0].ProductID)
+	assert.Equal(t, "abc", prods[0].Code)
+	assert.Equal(t, "Some", prods[0].Name)
+	assert.Equal(t, sharedCommon.ExtraFields{"newField": json.RawMessage(`{"a":1}`)}, prods[0].Extra)
+}
+
+func TestGetProductsWithFilters(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		common.AssertFormValues(t, r, map[string]interface{}{
+			"request":       "getProducts",
+			"productIDs":    "1,2",
+			"getStockInfo":  "1",
+			"active":        "0",
+			"changedSince":  "1577836800",
+			"recordsOnPage": "2",
+			"getFIFOCost":   "",
+		})
+
+		resp := GetProductsResponse{
+			Status:   sharedCommon.Status{ResponseStatus: "ok"},
+			Products: []Product{{ProductID: 1}, {ProductID: 2}},
+		}
+		jsonRaw, err := json.Marshal(resp)
+		assert.NoError(t, err)
+
+		_, err = w.Write(jsonRaw)
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	cli := common.NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	cl := NewClient(cli)
+
+	active := false
+	prods, err := cl.GetProductsWithFilters(context.Background(), GetProductsFilters{
+		ProductIDs:   []int{1, 2},
+		GetStockInfo: true,
+		Active:       &active,
+		ChangedSince: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Pagination:   sharedCommon.Pagination{RecordsOnPage: 2},
+	})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	assert.Len(t, prods, 2)
+	assert.Equal(t, 1, prods[0].ProductID)
+	assert.Equal(t, 2, prods[1].ProductID)
+}
+
 func TestGetProductsBulk(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		statusBulk := sharedCommon.StatusBulk{}
@@ -439,6 +522,72 @@ func TestSaveProductBulk(t *testing.T) {
 	assert.Equal(t, expectedStatus, bulkResp.BulkItems[2].Status)
 }
 
+func TestSaveProductBulkPartialFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		okStatus := sharedCommon.StatusBulk{RequestName: "saveProduct", RequestID: "1"}
+		okStatus.ResponseStatus = "ok"
+
+		failedStatus := sharedCommon.StatusBulk{RequestName: "saveProduct", RequestID: "2"}
+		failedStatus.ResponseStatus = "error"
+		failedStatus.ErrorCode = sharedCommon.RequiredParamMissing
+		failedStatus.ErrorField = "groupID"
+
+		bulkResp := SaveProductResponseBulk{
+			Status: sharedCommon.Status{ResponseStatus: "ok"},
+			BulkItems: []SaveProductResponseBulkItem{
+				{
+					Status:   okStatus,
+					Products: []SaveProductResult{{ProductID: 123}},
+				},
+				{
+					Status: failedStatus,
+				},
+			},
+		}
+		jsonRaw, err := json.Marshal(bulkResp)
+		assert.NoError(t, err)
+
+		_, err = w.Write(jsonRaw)
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	inpt := []map[string]interface{}{
+		{
+			"requestID": "1",
+			"groupID":   "4",
+			"code":      "code1",
+		},
+		{
+			"requestID": "2",
+			"code":      "code2",
+		},
+	}
+
+	cli := common.NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	cl := NewClient(cli)
+
+	bulkResp, err := cl.SaveProductBulk(context.Background(), inpt, map[string]string{})
+
+	bulkErr, ok := err.(*sharedCommon.BulkError)
+	assert.True(t, ok)
+	if !ok {
+		return
+	}
+
+	assert.Equal(t, []int{1}, bulkErr.FailedIndexes())
+	assert.Equal(t, 2, bulkErr.ItemsCount)
+	assert.Equal(t, "2", bulkErr.Failures[0].RequestID)
+	assert.Equal(t, sharedCommon.RequiredParamMissing, bulkErr.Failures[0].Code)
+	assert.Equal(t, "groupID", bulkErr.Failures[0].ErrorField)
+
+	assert.Len(t, bulkResp.BulkItems, 2)
+	assert.Equal(t, []SaveProductResult{{ProductID: 123}}, bulkResp.BulkItems[0].Products)
+}
+
 func TestDeleteProduct(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := DeleteProductResponse{
@@ -1841,7 +1990,7 @@ func TestDeleteProductGroupBulk(t *testing.T) {
 }
 
 func TestGetProductPriorityGroupBulk(t *testing.T) {
-	nowTimeStamp := time.Now().Unix()
+	nowTimeStamp := sharedCommon.NewTimestamp(time.Now())
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		statusBulk := sharedCommon.StatusBulk{}
 		statusBulk.ResponseStatus = "ok"
@@ -1855,12 +2004,12 @@ func TestGetProductPriorityGroupBulk(t *testing.T) {
 			{
 				"recordsOnPage": "10",
 				"pageNo":        "1",
-				"requestName":"getProductPriorityGroups",
+				"requestName":   "getProductPriorityGroups",
 			},
 			{
 				"recordsOnPage": "10",
 				"pageNo":        "2",
-				"requestName":"getProductPriorityGroups",
+				"requestName":   "getProductPriorityGroups",
 			},
 		})
 
@@ -1957,12 +2106,12 @@ func TestGetProductGroupsBulk(t *testing.T) {
 			{
 				"recordsOnPage": "10",
 				"pageNo":        "1",
-				"requestName":"getProductGroups",
+				"requestName":   "getProductGroups",
 			},
 			{
 				"recordsOnPage": "10",
 				"pageNo":        "2",
-				"requestName":"getProductGroups",
+				"requestName":   "getProductGroups",
 			},
 		})
 
@@ -1973,7 +2122,7 @@ func TestGetProductGroupsBulk(t *testing.T) {
 					Status: statusBulk,
 					Records: []ProductGroup{
 						{
-							ID:   1,
+							ID: 1,
 							NameLanguages: NameLanguages{
 								Name: "Prod Group 1",
 							},
@@ -1984,7 +2133,7 @@ func TestGetProductGroupsBulk(t *testing.T) {
 					Status: statusBulk,
 					Records: []ProductGroup{
 						{
-							ID:   2,
+							ID: 2,
 							NameLanguages: NameLanguages{
 								Name: "Prod Group 2",
 							},
@@ -2055,12 +2204,12 @@ func TestGetProductCategoriesBulk(t *testing.T) {
 			{
 				"recordsOnPage": "10",
 				"pageNo":        "1",
-				"requestName":"getProductCategories",
+				"requestName":   "getProductCategories",
 			},
 			{
 				"recordsOnPage": "10",
 				"pageNo":        "2",
-				"requestName":"getProductCategories",
+				"requestName":   "getProductCategories",
 			},
 		})
 
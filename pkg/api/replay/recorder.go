@@ -0,0 +1,379 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+//Mode tells if the Recorder sends the requests to the API or replays them from the fixture file
+type Mode int
+
+const (
+	//ModeReplay gives the recorded responses and never sends requests to the API
+	ModeReplay Mode = iota
+	//ModeRecord sends the requests to the API and keeps the interactions till Save is called
+	ModeRecord
+)
+
+//ErrNoInteraction is returned in the replay mode for the requests which were not recorded
+var ErrNoInteraction = errors.New("replay: no recorded interaction matches the request")
+
+//Interaction is a recorded request with its response
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+//Request is the part of the API request which is used for matching, the values of the sensitive parameters are redacted
+type Request struct {
+	//Name is the ERPLY request name or sharedCommon.BulkRequestMethod for bulk requests
+	Name string `json:"name"`
+	//Filters are the parameters of the request from the URL and the body, except the request name and the bulk sub-requests
+	Filters map[string]string `json:"filters"`
+	//SubRequests are the sub-requests of a bulk request
+	SubRequests []map[string]interface{} `json:"subRequests,omitempty"`
+}
+
+//Response is the recorded API response with the sensitive values redacted
+type Response struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+//Fixture is the content of a fixture file
+type Fixture struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+//Recorder is an http.RoundTripper which records the API requests and responses into a fixture file and replays them,
+//so the tests of the code using the SDK can run offline. In the replay mode the requests are matched by the ERPLY
+//request name and filters, identical requests get the recorded responses in the recorded order and the last one
+//is repeated once they are used up
+type Recorder struct {
+	//Transport sends the requests in the record mode, http.DefaultTransport is used if it's nil
+	Transport http.RoundTripper
+	//IgnoredParams are not compared when matching requests, e.g. parameters with timestamps
+	IgnoredParams []string
+
+	mode         Mode
+	fixturePath  string
+	lock         sync.Mutex
+	interactions []Interaction
+	usedCounts   []int
+}
+
+//New creates a Recorder for the fixture file, in the replay mode the file is loaded
+func New(fixturePath string, mode Mode) (*Recorder, error) {
+	r := &Recorder{
+		mode:        mode,
+		fixturePath: fixturePath,
+	}
+
+	if mode == ModeReplay {
+		fixture, err := LoadFixture(fixturePath)
+		if err != nil {
+			return nil, err
+		}
+		r.interactions = fixture.Interactions
+		r.usedCounts = make([]int, len(r.interactions))
+	}
+
+	return r, nil
+}
+
+//LoadFixture reads the interactions from the fixture file
+func LoadFixture(fixturePath string) (Fixture, error) {
+	var fixture Fixture
+
+	data, err := ioutil.ReadFile(fixturePath)
+	if err != nil {
+		return fixture, fmt.Errorf("replay: failed to read fixture %s: %w", fixturePath, err)
+	}
+
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return fixture, fmt.Errorf("replay: failed to decode fixture %s: %w", fixturePath, err)
+	}
+
+	return fixture, nil
+}
+
+//Client gives an http.Client which sends the requests through the recorder, give it to the ClientBuilder as HttpCli
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+//Interactions gives a copy of the recorded or loaded interactions
+func (r *Recorder) Interactions() []Interaction {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return append([]Interaction{}, r.interactions...)
+}
+
+//Save writes the recorded interactions to the fixture file, it does nothing in the replay mode
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.lock.Lock()
+	data, err := json.MarshalIndent(Fixture{Interactions: r.interactions}, "", "  ")
+	r.lock.Unlock()
+	if err != nil {
+		return fmt.Errorf("replay: failed to encode fixture: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.fixturePath), 0755); err != nil {
+		return fmt.Errorf("replay: failed to create fixture directory: %w", err)
+	}
+
+	if err := ioutil.WriteFile(r.fixturePath, data, 0644); err != nil {
+		return fmt.Errorf("replay: failed to write fixture %s: %w", r.fixturePath, err)
+	}
+
+	return nil
+}
+
+//RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recordedReq, outReq, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(outReq, recordedReq)
+	}
+
+	return r.replay(outReq, recordedReq)
+}
+
+func (r *Recorder) record(req *http.Request, recordedReq Request) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+
+	r.lock.Lock()
+	r.interactions = append(r.interactions, Interaction{
+		Request: recordedReq,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       sharedCommon.RedactText(string(body)),
+		},
+	})
+	r.lock.Unlock()
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recordedReq Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	lastMatch := -1
+	for i, interaction := range r.interactions {
+		if !r.matches(interaction.Request, recordedReq) {
+			continue
+		}
+		lastMatch = i
+		if r.usedCounts[i] == 0 {
+			break
+		}
+	}
+	if lastMatch < 0 {
+		return nil, fmt.Errorf("%w: %s with filters %v", ErrNoInteraction, recordedReq.Name, recordedReq.Filters)
+	}
+	r.usedCounts[lastMatch]++
+
+	recordedResp := r.interactions[lastMatch].Response
+	header := recordedResp.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recordedResp.StatusCode, http.StatusText(recordedResp.StatusCode)),
+		StatusCode:    recordedResp.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(recordedResp.Body)),
+		ContentLength: int64(len(recordedResp.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) matches(recorded, actual Request) bool {
+	if recorded.Name != actual.Name {
+		return false
+	}
+
+	if !reflect.DeepEqual(r.withoutIgnored(recorded.Filters), r.withoutIgnored(actual.Filters)) {
+		return false
+	}
+
+	if len(recorded.SubRequests) != len(actual.SubRequests) {
+		return false
+	}
+	for i := range recorded.SubRequests {
+		if !reflect.DeepEqual(r.withoutIgnoredInSubRequest(recorded.SubRequests[i]), r.withoutIgnoredInSubRequest(actual.SubRequests[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *Recorder) withoutIgnored(filters map[string]string) map[string]string {
+	res := make(map[string]string, len(filters))
+	for key, value := range filters {
+		if !r.isIgnored(key) {
+			res[key] = value
+		}
+	}
+
+	return res
+}
+
+func (r *Recorder) withoutIgnoredInSubRequest(filters map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(filters))
+	for key, value := range filters {
+		if !r.isIgnored(key) {
+			res[key] = value
+		}
+	}
+
+	return res
+}
+
+func (r *Recorder) isIgnored(param string) bool {
+	for _, ignoredParam := range r.IgnoredParams {
+		if strings.EqualFold(ignoredParam, param) {
+			return true
+		}
+	}
+
+	return false
+}
+
+//newRequest extracts the request name and the redacted parameters from the URL and the form encoded body,
+//it also gives the request which should be sent further, see readBody
+func newRequest(req *http.Request) (Request, *http.Request, error) {
+	params := url.Values{}
+	for key, values := range req.URL.Query() {
+		params[key] = values
+	}
+
+	body, outReq, err := readBody(req)
+	if err != nil {
+		return Request{}, nil, fmt.Errorf("replay: failed to read request body: %w", err)
+	}
+	if body != nil {
+		bodyValues, err := url.ParseQuery(string(body))
+		if err != nil {
+			return Request{}, nil, fmt.Errorf("replay: failed to parse request body: %w", err)
+		}
+		for key, values := range bodyValues {
+			params[key] = append(params[key], values...)
+		}
+	}
+
+	recordedReq := Request{
+		Name:    params.Get("request"),
+		Filters: map[string]string{},
+	}
+	params.Del("request")
+
+	if bulkRequests := params.Get("requests"); bulkRequests != "" {
+		recordedReq.Name = sharedCommon.BulkRequestMethod
+		if err := json.Unmarshal([]byte(bulkRequests), &recordedReq.SubRequests); err != nil {
+			return Request{}, nil, fmt.Errorf("replay: failed to decode bulk requests: %w", err)
+		}
+		for _, subRequest := range recordedReq.SubRequests {
+			for key := range subRequest {
+				if sharedCommon.IsSensitiveParam(key) {
+					subRequest[key] = sharedCommon.RedactedValue
+				}
+			}
+		}
+		params.Del("requests")
+	}
+
+	for key, value := range sharedCommon.RedactValues(params) {
+		recordedReq.Filters[key] = strings.Join(value, ",")
+	}
+
+	return recordedReq, outReq, nil
+}
+
+//readBody reads the request body without changing the request, a clone with the body from GetBody is read
+//and the original request is sent further, if GetBody isn't set the original body is consumed
+//and a clone carrying the read bytes is sent instead
+func readBody(req *http.Request) ([]byte, *http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, req, nil
+	}
+
+	if req.GetBody != nil {
+		clone := req.Clone(req.Context())
+		var err error
+		clone.Body, err = req.GetBody()
+		if err != nil {
+			return nil, nil, err
+		}
+		defer clone.Body.Close()
+
+		body, err := ioutil.ReadAll(clone.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return body, req, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outReq := req.Clone(req.Context())
+	outReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+	outReq.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return body, outReq, nil
+}
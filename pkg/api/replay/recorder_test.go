@@ -0,0 +1,180 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"github.com/erply/api-go-wrapper/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//redirectingTransport sends all requests to the test server, so the verifyUser calls of the session provider reach it too
+type redirectingTransport struct {
+	target *url.URL
+}
+
+func (rt redirectingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = rt.target.Scheme
+	req.URL.Host = rt.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	productsCalls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Get("request") == "verifyUser":
+			_, _ = w.Write([]byte(`{"status":{"request":"verifyUser","responseStatus":"ok"},"records":[{"sessionKey":"secretsess","sessionLength":3600}]}`))
+		case r.URL.Query().Get("request") == "getProducts":
+			productsCalls++
+			if productsCalls == 1 {
+				_, _ = w.Write([]byte(`{"status":{"request":"getProducts","responseStatus":"ok"},"records":[{"productID":1,"name":"first"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"status":{"request":"getProducts","responseStatus":"ok"},"records":[{"productID":2,"name":"second"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"status":{"responseStatus":"ok"},"requests":[{"status":{"requestName":"getProducts","responseStatus":"ok"},"records":[{"productID":3}]}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	srvURL, err := url.Parse(srv.URL)
+	assert.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "replay")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	fixturePath := filepath.Join(dir, "fixtures", "products.json")
+
+	runFlow := func(recorder *Recorder) (names []string) {
+		cli := api.ClientBuilder{
+			ClientCode: "123",
+			UserName:   "user",
+			Password:   "secretpass",
+			URL:        "https://123.erply.com/api/",
+			HttpCli:    recorder.Client(),
+		}.Build()
+
+		for i := 0; i < 2; i++ {
+			products, err := cli.ProductManager.GetProducts(context.Background(), map[string]string{"recordsOnPage": "1"})
+			assert.NoError(t, err)
+			for _, product := range products {
+				names = append(names, product.Name)
+			}
+		}
+
+		bulkResp, err := cli.ProductManager.GetProductsBulk(
+			context.Background(),
+			[]map[string]interface{}{{"pageNo": 1}},
+			map[string]string{},
+		)
+		assert.NoError(t, err)
+		if assert.Len(t, bulkResp.BulkItems, 1) && assert.Len(t, bulkResp.BulkItems[0].Products, 1) {
+			assert.Equal(t, 3, bulkResp.BulkItems[0].Products[0].ProductID)
+		}
+
+		return names
+	}
+
+	recorder, err := New(fixturePath, ModeRecord)
+	assert.NoError(t, err)
+	recorder.Transport = redirectingTransport{target: srvURL}
+	assert.Equal(t, []string{"first", "second"}, runFlow(recorder))
+	assert.NoError(t, recorder.Save())
+	assert.Len(t, recorder.Interactions(), 4)
+
+	fixtureData, err := ioutil.ReadFile(fixturePath)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(fixtureData), "secretsess")
+	assert.NotContains(t, string(fixtureData), "secretpass")
+
+	srv.Close()
+
+	replayer, err := New(fixturePath, ModeReplay)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, runFlow(replayer))
+
+	cli := api.ClientBuilder{ClientCode: "123", SessionKey: "somesess", URL: srv.URL, HttpCli: replayer.Client()}.Build()
+	_, err = cli.ProductManager.GetProducts(context.Background(), map[string]string{"recordsOnPage": "2"})
+	assert.True(t, errors.Is(err, ErrNoInteraction))
+}
+
+func TestIgnoredParams(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replay")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	fixturePath := filepath.Join(dir, "fixture.json")
+
+	assert.NoError(t, ioutil.WriteFile(fixturePath, []byte(`{"interactions":[{
+		"request":{"name":"getProducts","filters":{"clientCode":"123","changedSince":"1"}},
+		"response":{"statusCode":200,"body":"{\"status\":{\"responseStatus\":\"ok\"},\"records\":[]}"}
+	}]}`), 0644))
+
+	replayer, err := New(fixturePath, ModeReplay)
+	assert.NoError(t, err)
+
+	sendRequest := func() error {
+		req, err := http.NewRequest(http.MethodPost, "https://123.erply.com/api/?request=getProducts&clientCode=123&changedSince=2", nil)
+		assert.NoError(t, err)
+		resp, err := replayer.RoundTrip(req)
+		if err == nil {
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+		return err
+	}
+
+	assert.True(t, errors.Is(sendRequest(), ErrNoInteraction))
+
+	replayer.IgnoredParams = []string{"changedSince"}
+	assert.NoError(t, sendRequest())
+
+	_, err = New(filepath.Join(dir, "missing.json"), ModeReplay)
+	assert.Error(t, err)
+}
+
+func TestRecordLeavesRequestUntouched(t *testing.T) {
+	receivedBodies := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		receivedBodies = append(receivedBodies, string(body))
+		_, _ = w.Write([]byte(`{"status":{"responseStatus":"ok"},"records":[]}`))
+	}))
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "replay")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	recorder, err := New(filepath.Join(dir, "fixture.json"), ModeRecord)
+	assert.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader("request=getProducts&clientCode=123"))
+	assert.NoError(t, err)
+	body := req.Body
+
+	_, err = recorder.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.True(t, body == req.Body)
+
+	req, err = http.NewRequest(http.MethodPost, srv.URL, strings.NewReader("request=getProducts&clientCode=456"))
+	assert.NoError(t, err)
+	req.GetBody = nil
+	body = req.Body
+
+	_, err = recorder.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.True(t, body == req.Body)
+
+	assert.Equal(t, []string{"request=getProducts&clientCode=123", "request=getProducts&clientCode=456"}, receivedBodies)
+	assert.Len(t, recorder.interactions, 2)
+	assert.Equal(t, "getProducts", recorder.interactions[1].Request.Name)
+}
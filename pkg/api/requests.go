@@ -101,19 +101,15 @@ func (cli *Client) GetEmployeesBulk(ctx context.Context, bulkFilters []map[strin
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetEmployeesResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetEmployeesResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(bulkItem.Status.ErrorCode.String(), bulkItem.Status.Request+": "+bulkItem.Status.ResponseStatus, bulkResp.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 // GetBusinessAreas will list business areas according to specified filters.
@@ -193,7 +189,7 @@ func (c *Client) SaveEvent(ctx context.Context, filters map[string]string) (int,
 	if !common.IsJSONResponseOK(&res.Status) {
 		return 0, sharedCommon.NewFromResponseStatus(&res.Status)
 	}
-	return res.Records[0].EventID, nil
+	return res.Records[0].EventID.Int(), nil
 }
 
 func (c *Client) GetEvents(ctx context.Context, filters map[string]string) ([]Event, error) {
@@ -1,7 +1,8 @@
 package sales
 
 import (
-	"encoding/json"
+	"time"
+
 	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
 )
 
@@ -20,14 +21,14 @@ const (
 
 type (
 	SaleDocument struct {
-		ID            int    `json:"id"`
-		CurrencyRate  string `json:"currencyRate"`
-		WarehouseID   int    `json:"warehouseID"`
-		WarehouseName string `json:"warehouseName"`
-		Number        string `json:"number"`
-		Date          string `json:"date"`
-		DeliveryDate  string `json:"deliveryDate"`
-		Time          string `json:"time"`
+		ID            int               `json:"id"`
+		CurrencyRate  string            `json:"currencyRate"`
+		WarehouseID   int               `json:"warehouseID"`
+		WarehouseName string            `json:"warehouseName"`
+		Number        string            `json:"number"`
+		Date          sharedCommon.Date `json:"date"`
+		DeliveryDate  sharedCommon.Date `json:"deliveryDate"`
+		Time          string            `json:"time"`
 
 		//Payer if invoice_client_is_payer = 1
 		ClientID    int    `json:"clientID"`
@@ -39,128 +40,131 @@ type (
 		//Payer if invoice_client_is_payer = 0
 		PayerID int `json:"payerID"`
 
-		AddressID                int                 `json:"addressID"`
-		Address                  string              `json:"address"`
-		PayerAddressID           int                 `json:"payerAddressID"`
-		ShipToAddressID          string              `json:"shipToAddressID"`
-		ContactID                int                 `json:"contactID"`
-		EmployeeID               int                 `json:"employeeID"`
-		PaymentDays              string              `json:"paymentDays"`
-		Confirmed                string              `json:"confirmed"`
-		Notes                    string              `json:"notes"`
-		InternalNotes            string              `json:"internalNotes"`
-		PackingUnitsDescription  string              `json:"packingUnitsDescription"`
-		InventoryTransactionDate string              `json:"inventoryTransactionDate"`
-		CurrencyCode             string              `json:"currencyCode"`
-		ContactName              string              `json:"contactName"`
-		ClientName               string              `json:"clientName"`
-		ClientCardNumber         string              `json:"clientCardNumber"`
-		Type                     string              `json:"type"`
-		InvoiceState             string              `json:"invoiceState"`
-		PaymentType              string              `json:"paymentType"`
-		BaseDocuments            []BaseDocument      `json:"baseDocuments"`
-		FollowUpDocuments        []BaseDocument      `json:"followUpDocuments"`
-		NetTotal                 float64             `json:"netTotal"`
-		VatTotal                 float64             `json:"vatTotal"`
-		VatTotalsByTaxRates      VatTotalsByTaxRates `json:"vatTotalsByTaxRate"`
-		Rounding                 float64             `json:"rounding"`
-		Total                    float64             `json:"total"`
-		Paid                     string              `json:"paid"`
-		PrintDiscounts           int                 `json:"printDiscounts"`
-		ReferenceNumber          string              `json:"referenceNumber"`
-		CustomReferenceNumber    string              `json:"customReferenceNumber"`
-		PaymentStatus            string              `json:"paymentStatus"`
-		Penalty                  string              `json:"penalty"`
-		InvoiceLink              string              `json:"invoiceLink"`
-		EmployeeName             string              `json:"employeeName"`
-		TransportTypeName        string              `json:"transportTypeName"`
-		ShipToName               string              `json:"shipToName"`
-		ShippingDate             string              `json:"shippingDate"`
-		InvoiceRows              []InvoiceRow        `json:"rows"`
+		AddressID                int                    `json:"addressID"`
+		Address                  string                 `json:"address"`
+		PayerAddressID           int                    `json:"payerAddressID"`
+		ShipToAddressID          sharedCommon.FlexInt   `json:"shipToAddressID"`
+		ContactID                int                    `json:"contactID"`
+		EmployeeID               int                    `json:"employeeID"`
+		PaymentDays              sharedCommon.FlexInt   `json:"paymentDays"`
+		Confirmed                sharedCommon.FlexBool  `json:"confirmed"`
+		Notes                    string                 `json:"notes"`
+		InternalNotes            string                 `json:"internalNotes"`
+		PackingUnitsDescription  string                 `json:"packingUnitsDescription"`
+		InventoryTransactionDate sharedCommon.Date      `json:"inventoryTransactionDate"`
+		CurrencyCode             string                 `json:"currencyCode"`
+		ContactName              string                 `json:"contactName"`
+		ClientName               string                 `json:"clientName"`
+		ClientCardNumber         string                 `json:"clientCardNumber"`
+		Type                     string                 `json:"type"`
+		InvoiceState             string                 `json:"invoiceState"`
+		PaymentType              string                 `json:"paymentType"`
+		BaseDocuments            []BaseDocument         `json:"baseDocuments"`
+		FollowUpDocuments        []BaseDocument         `json:"followUpDocuments"`
+		NetTotal                 sharedCommon.Money     `json:"netTotal"`
+		VatTotal                 sharedCommon.Money     `json:"vatTotal"`
+		VatTotalsByTaxRates      VatTotalsByTaxRates    `json:"vatTotalsByTaxRate"`
+		Rounding                 sharedCommon.Money     `json:"rounding"`
+		Total                    sharedCommon.Money     `json:"total"`
+		Paid                     sharedCommon.Money     `json:"paid"`
+		PrintDiscounts           int                    `json:"printDiscounts"`
+		ReferenceNumber          string                 `json:"referenceNumber"`
+		CustomReferenceNumber    string                 `json:"customReferenceNumber"`
+		PaymentStatus            string                 `json:"paymentStatus"`
+		Penalty                  sharedCommon.FlexFloat `json:"penalty"`
+		InvoiceLink              string                 `json:"invoiceLink"`
+		EmployeeName             string                 `json:"employeeName"`
+		TransportTypeName        string                 `json:"transportTypeName"`
+		ShipToName               string                 `json:"shipToName"`
+		ShippingDate             sharedCommon.Date      `json:"shippingDate"`
+		InvoiceRows              []InvoiceRow           `json:"rows"`
 		sharedCommon.Attributes
-		ExportInvoiceType               string              `json:"exportInvoiceType"`
-		PointOfSaleID                   int                 `json:"pointOfSaleID"`
-		PricelistID                     string              `json:"pricelistID"`
-		PointOfSaleName                 string              `json:"pointOfSaleName"`
-		ClientFactoringContractNumber   string              `json:"clientFactoringContractNumber"`
-		ClientPaysViaFactoring          int                 `json:"clientPaysViaFactoring"`
-		PayerName                       string              `json:"payerName"`
-		PayerAddress                    string              `json:"payerAddress"`
-		PayerFactoringContractNumber    string              `json:"payerFactoringContractNumber"`
-		PayerPaysViaFactoring           string              `json:"payerPaysViaFactoring"`
-		ShipToAddress                   string              `json:"shipToAddress"`
-		ShipToContactID                 int                 `json:"shipToContactID"`
-		ShipToContactName               string              `json:"shipToContactName"`
-		ProjectID                       int                 `json:"projectID"`
-		PreviousReturnsExist            int                 `json:"previousReturnsExist"`
-		NetTotalsByTaxRate              VatTotalsByTaxRates `json:"netTotalsByTaxRate"`
-		ExternalNetTotal                float64             `json:"externalNetTotal"`
-		ExternalVatTotal                float64             `json:"externalVatTotal"`
-		ExternalRounding                float64             `json:"externalRounding"`
-		ExternalTotal                   float64             `json:"externalTotal"`
-		PaymentTypeID                   int                 `json:"paymentTypeID"`
-		TaxExemptCertificateNumber      string              `json:"taxExemptCertificateNumber"`
-		PackerID                        int                 `json:"packerID"`
-		TrackingNumber                  string              `json:"trackingNumber"`
-		FulfillmentStatus               string              `json:"fulfillmentStatus"`
-		Cost                            float64             `json:"cost"`
-		ReserveGoods                    int                 `json:"reserveGoods"`
-		ReserveGoodsUntilDate           string              `json:"reserveGoodsUntilDate"`
-		DeliveryTypeID                  int                 `json:"deliveryTypeID"`
-		DeliveryTypeName                string              `json:"deliveryTypeName"`
-		TriangularTransaction           string              `json:"triangularTransaction"`
-		PurchaseOrderDone               string              `json:"purchaseOrderDone"`
-		TransactionTypeID               int                 `json:"transactionTypeID"`
-		TransactionTypeName             string              `json:"transactionTypeName"`
-		TransportTypeID                 int                 `json:"transportTypeID"`
-		DeliveryTerms                   string              `json:"deliveryTerms"`
-		EuInvoiceType                   string              `json:"euInvoiceType"`
-		DeliveryTermsLocation           string              `json:"deliveryTermsLocation"`
-		DeliveryOnlyWhenAllItemsInStock int                 `json:"deliveryOnlyWhenAllItemsInStock"`
-		LastModified                    int64               `json:"lastModified"`
-		LastModifierUsername            string              `json:"lastModifierUsername"`
-		Added                           int                 `json:"added"`
-		ReceiptLink                     string              `json:"receiptLink"`
-		AmountAddedToStoreCredit        json.Number         `json:"amountAddedToStoreCredit"`
-		AmountPaidWithStoreCredit       json.Number         `json:"amountPaidWithStoreCredit"`
-		ApplianceID                     int                 `json:"applianceID"`
-		ApplianceReference              string              `json:"applianceReference"`
-		AssignmentID                    int                 `json:"assignmentID"`
-		VehicleMileage                  int                 `json:"vehicleMileage"`
+		ExportInvoiceType               string                 `json:"exportInvoiceType"`
+		PointOfSaleID                   int                    `json:"pointOfSaleID"`
+		PricelistID                     sharedCommon.FlexInt   `json:"pricelistID"`
+		PointOfSaleName                 string                 `json:"pointOfSaleName"`
+		ClientFactoringContractNumber   string                 `json:"clientFactoringContractNumber"`
+		ClientPaysViaFactoring          int                    `json:"clientPaysViaFactoring"`
+		PayerName                       string                 `json:"payerName"`
+		PayerAddress                    string                 `json:"payerAddress"`
+		PayerFactoringContractNumber    string                 `json:"payerFactoringContractNumber"`
+		PayerPaysViaFactoring           sharedCommon.FlexBool  `json:"payerPaysViaFactoring"`
+		ShipToAddress                   string                 `json:"shipToAddress"`
+		ShipToContactID                 int                    `json:"shipToContactID"`
+		ShipToContactName               string                 `json:"shipToContactName"`
+		ProjectID                       int                    `json:"projectID"`
+		PreviousReturnsExist            int                    `json:"previousReturnsExist"`
+		NetTotalsByTaxRate              VatTotalsByTaxRates    `json:"netTotalsByTaxRate"`
+		ExternalNetTotal                sharedCommon.Money     `json:"externalNetTotal"`
+		ExternalVatTotal                sharedCommon.Money     `json:"externalVatTotal"`
+		ExternalRounding                sharedCommon.Money     `json:"externalRounding"`
+		ExternalTotal                   sharedCommon.Money     `json:"externalTotal"`
+		PaymentTypeID                   int                    `json:"paymentTypeID"`
+		TaxExemptCertificateNumber      string                 `json:"taxExemptCertificateNumber"`
+		PackerID                        int                    `json:"packerID"`
+		TrackingNumber                  string                 `json:"trackingNumber"`
+		FulfillmentStatus               string                 `json:"fulfillmentStatus"`
+		Cost                            sharedCommon.Money     `json:"cost"`
+		ReserveGoods                    int                    `json:"reserveGoods"`
+		ReserveGoodsUntilDate           sharedCommon.Date      `json:"reserveGoodsUntilDate"`
+		DeliveryTypeID                  int                    `json:"deliveryTypeID"`
+		DeliveryTypeName                string                 `json:"deliveryTypeName"`
+		TriangularTransaction           string                 `json:"triangularTransaction"`
+		PurchaseOrderDone               string                 `json:"purchaseOrderDone"`
+		TransactionTypeID               int                    `json:"transactionTypeID"`
+		TransactionTypeName             string                 `json:"transactionTypeName"`
+		TransportTypeID                 int                    `json:"transportTypeID"`
+		DeliveryTerms                   string                 `json:"deliveryTerms"`
+		EuInvoiceType                   string                 `json:"euInvoiceType"`
+		DeliveryTermsLocation           string                 `json:"deliveryTermsLocation"`
+		DeliveryOnlyWhenAllItemsInStock int                    `json:"deliveryOnlyWhenAllItemsInStock"`
+		LastModified                    sharedCommon.Timestamp `json:"lastModified"`
+		LastModifierUsername            string                 `json:"lastModifierUsername"`
+		Added                           sharedCommon.Timestamp `json:"added"`
+		ReceiptLink                     string                 `json:"receiptLink"`
+		AmountAddedToStoreCredit        sharedCommon.FlexFloat `json:"amountAddedToStoreCredit"`
+		AmountPaidWithStoreCredit       sharedCommon.FlexFloat `json:"amountPaidWithStoreCredit"`
+		ApplianceID                     int                    `json:"applianceID"`
+		ApplianceReference              string                 `json:"applianceReference"`
+		AssignmentID                    int                    `json:"assignmentID"`
+		VehicleMileage                  int                    `json:"vehicleMileage"`
+
+		//Extra contains the fields which are not declared above, it's filled only if ClientBuilder.KeepExtraFields is enabled
+		Extra sharedCommon.ExtraFields `json:"-"`
 	}
 
 	InvoiceRow struct {
-		RowID             string      `json:"rowID"`
-		StableRowID       string      `json:"stableRowID"`
-		ProductID         string      `json:"productID"`
-		ItemName          string      `json:"itemName"`
-		Barcode           string      `json:"barcode"`
-		VatrateID         string      `json:"vatrateID"`
-		Amount            string      `json:"amount"`
-		Price             string      `json:"price"`
-		Discount          string      `json:"discount"`
-		BillingStartDate  string      `json:"billingStartDate"`
-		BillingEndDate    string      `json:"billingEndDate"`
-		Code              string      `json:"code"`
-		Code2             string      `json:"code2"`
-		FinalNetPrice     float64     `json:"finalNetPrice"`
-		FinalPriceWithVAT float64     `json:"finalPriceWithVAT"`
-		RowNetTotal       float64     `json:"rowNetTotal"`
-		RowVAT            float64     `json:"rowVAT"`
-		RowTotal          float64     `json:"rowTotal"`
-		CampaignIDs       string      `json:"campaignIDs"`
-		Jdoc              interface{} `json:"jdoc"`
+		RowID             sharedCommon.FlexInt   `json:"rowID"`
+		StableRowID       sharedCommon.FlexInt   `json:"stableRowID"`
+		ProductID         sharedCommon.FlexInt   `json:"productID"`
+		ItemName          string                 `json:"itemName"`
+		Barcode           string                 `json:"barcode"`
+		VatrateID         sharedCommon.FlexInt   `json:"vatrateID"`
+		Amount            sharedCommon.FlexFloat `json:"amount"`
+		Price             sharedCommon.Money     `json:"price"`
+		Discount          sharedCommon.FlexFloat `json:"discount"`
+		BillingStartDate  sharedCommon.Date      `json:"billingStartDate"`
+		BillingEndDate    sharedCommon.Date      `json:"billingEndDate"`
+		Code              string                 `json:"code"`
+		Code2             string                 `json:"code2"`
+		FinalNetPrice     sharedCommon.Money     `json:"finalNetPrice"`
+		FinalPriceWithVAT sharedCommon.Money     `json:"finalPriceWithVAT"`
+		RowNetTotal       sharedCommon.Money     `json:"rowNetTotal"`
+		RowVAT            sharedCommon.Money     `json:"rowVAT"`
+		RowTotal          sharedCommon.Money     `json:"rowTotal"`
+		CampaignIDs       string                 `json:"campaignIDs"`
+		Jdoc              interface{}            `json:"jdoc"`
 	}
 	VatTotalsByTaxRates []VatTotalsByTaxRate
 	VatTotalsByTaxRate  struct {
-		VatrateID int     `json:"vatrateID"`
-		Total     float64 `json:"total"`
+		VatrateID sharedCommon.FlexInt `json:"vatrateID"`
+		Total     sharedCommon.Money   `json:"total"`
 	}
 	BaseDocument struct {
-		ID     int    `json:"id"`
-		Number string `json:"number"`
-		Type   string `json:"type"`
-		Date   string `json:"date"`
+		ID     int               `json:"id"`
+		Number string            `json:"number"`
+		Type   string            `json:"type"`
+		Date   sharedCommon.Date `json:"date"`
 	}
 
 	PostSalesDocumentResponse struct {
@@ -175,24 +179,24 @@ type (
 	SaleDocImportReports []SaleDocImportReport
 
 	SaveInvoiceRow struct {
-		RowID       int    `json:"rowID"`
-		StableRowID int    `json:"stableRowID"`
-		ProductID   int    `json:"productID"`
-		ServiceID   int    `json:"serviceID"`
-		Amount      string `json:"amount"`
+		RowID       sharedCommon.FlexInt   `json:"rowID"`
+		StableRowID sharedCommon.FlexInt   `json:"stableRowID"`
+		ProductID   sharedCommon.FlexInt   `json:"productID"`
+		ServiceID   int                    `json:"serviceID"`
+		Amount      sharedCommon.FlexFloat `json:"amount"`
 	}
 
 	SaleDocImportReport struct {
-		InvoiceID    int              `json:"invoiceID"`
-		InvoiceNo    string           `json:"invoiceNo"`
-		CustomNumber string           `json:"customNumber"`
-		InvoiceLink  string           `json:"invoiceLink"`
-		ReceiptLink  string           `json:"receiptLink"`
-		Net          float64          `json:"net"`
-		Vat          float64          `json:"vat"`
-		Rounding     float64          `json:"rounding"`
-		Total        float64          `json:"total"`
-		Rows         []SaveInvoiceRow `json:"rows"`
+		InvoiceID    int                `json:"invoiceID"`
+		InvoiceNo    string             `json:"invoiceNo"`
+		CustomNumber string             `json:"customNumber"`
+		InvoiceLink  string             `json:"invoiceLink"`
+		ReceiptLink  string             `json:"receiptLink"`
+		Net          sharedCommon.Money `json:"net"`
+		Vat          sharedCommon.Money `json:"vat"`
+		Rounding     sharedCommon.Money `json:"rounding"`
+		Total        sharedCommon.Money `json:"total"`
+		Rows         []SaveInvoiceRow   `json:"rows"`
 	}
 
 	SaveSalesDocumentBulkItem struct {
@@ -207,13 +211,13 @@ type (
 
 	PurchaseDocImportReports []PurchaseDocImportReport
 	PurchaseDocImportReport  struct {
-		InvoiceID    int     `json:"invoiceID"`
-		InvoiceRegNo string  `json:"invoiceRegNo"`
-		InvoiceNo    string  `json:"invoiceNo"`
-		InvoiceLink  string  `json:"invoiceLink"`
-		Vat          float64 `json:"vat"`
-		Total        float64 `json:"total"`
-		Net          float64 `json:"net"`
+		InvoiceID    int                `json:"invoiceID"`
+		InvoiceRegNo string             `json:"invoiceRegNo"`
+		InvoiceNo    string             `json:"invoiceNo"`
+		InvoiceLink  string             `json:"invoiceLink"`
+		Vat          sharedCommon.Money `json:"vat"`
+		Total        sharedCommon.Money `json:"total"`
+		Net          sharedCommon.Money `json:"net"`
 	}
 
 	SavePurchaseDocumentResponse struct {
@@ -242,6 +246,11 @@ type (
 	}
 )
 
+//DateTime gives the date and the time of the document in the location of the account, nil means UTC
+func (sd SaleDocument) DateTime(loc *time.Location) (time.Time, error) {
+	return sd.Date.At(sd.Time, loc)
+}
+
 func (spdr SavePurchaseDocumentResponse) GetStatus() *sharedCommon.Status {
 	return &spdr.Status
 }
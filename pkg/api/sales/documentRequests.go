@@ -30,6 +30,16 @@ func (cli *Client) SaveSalesDocument(ctx context.Context, filters map[string]str
 	return res.ImportReports, nil
 }
 
+//SaveSalesDocumentWithInput is SaveSalesDocument with the typed input
+func (cli *Client) SaveSalesDocumentWithInput(ctx context.Context, input SalesDocumentInput) (SaleDocImportReports, error) {
+	filters, err := sharedCommon.EncodeFilters(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli.SaveSalesDocument(ctx, filters)
+}
+
 func (cli *Client) SaveSalesDocumentBulk(
 	ctx context.Context,
 	bulkFilters []map[string]interface{},
@@ -53,19 +63,15 @@ func (cli *Client) SaveSalesDocumentBulk(
 	}
 
 	if err := json.Unmarshal(body, &respBulk); err != nil {
-		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveSalesDocumentResponseBulk from '%s': %v", string(body), err)
+		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal SaveSalesDocumentResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&respBulk.Status) {
-		return respBulk, sharedCommon.NewErplyError(respBulk.Status.ErrorCode.String(), respBulk.Status.Request+": "+respBulk.Status.ResponseStatus, respBulk.Status.ErrorCode)
+		return respBulk, sharedCommon.NewFromResponseStatus(&respBulk.Status)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(bulkRespItem.Status.ErrorCode.String(), bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus, respBulk.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return respBulk, nil
+	return respBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SavePurchaseDocument(ctx context.Context, filters map[string]string) (resp PurchaseDocImportReports, err error) {
@@ -105,27 +111,15 @@ func (cli *Client) SavePurchaseDocumentBulk(
 	}
 
 	if err := json.Unmarshal(body, &respBulk); err != nil {
-		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal SavePurchaseDocumentResponseBulk from '%s': %v", string(body), err)
+		return respBulk, fmt.Errorf("ERPLY API: failed to unmarshal SavePurchaseDocumentResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&respBulk.Status) {
-		return respBulk, sharedCommon.NewErplyError(
-			respBulk.Status.ErrorCode.String(),
-			respBulk.Status.Request+": "+respBulk.Status.ResponseStatus,
-			respBulk.Status.ErrorCode,
-		)
+		return respBulk, sharedCommon.NewFromResponseStatus(&respBulk.Status)
 	}
 
-	for _, bulkRespItem := range respBulk.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return respBulk, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				bulkRespItem.Status.Request+": "+bulkRespItem.Status.ResponseStatus,
-				respBulk.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return respBulk, nil
+	return respBulk, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) GetSalesDocuments(ctx context.Context, filters map[string]string) ([]SaleDocument, error) {
@@ -133,8 +127,12 @@ func (cli *Client) GetSalesDocuments(ctx context.Context, filters map[string]str
 	if err != nil {
 		return nil, sharedCommon.NewFromError("GetSalesDocument request failed", err, 0)
 	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, sharedCommon.NewFromError("reading GetSalesDocumentResponse failed", err, 0)
+	}
 	res := &GetSalesDocumentResponse{}
-	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+	if err := json.Unmarshal(body, &res); err != nil {
 		return nil, sharedCommon.NewFromError("unmarshaling GetSalesDocumentResponse failed", err, 0)
 	}
 
@@ -142,6 +140,10 @@ func (cli *Client) GetSalesDocuments(ctx context.Context, filters map[string]str
 		return nil, sharedCommon.NewFromResponseStatus(&res.Status)
 	}
 
+	if err := cli.DecodeExtraFields(body, res.SalesDocuments); err != nil {
+		return nil, err
+	}
+
 	if len(res.SalesDocuments) == 0 {
 		//intentionally, otherwise when the documents are cached the error will be triggered.
 		return nil, nil
@@ -150,13 +152,27 @@ func (cli *Client) GetSalesDocuments(ctx context.Context, filters map[string]str
 	return res.SalesDocuments, nil
 }
 
+//GetSalesDocumentsWithFilters is GetSalesDocuments with the typed filters
+func (cli *Client) GetSalesDocumentsWithFilters(ctx context.Context, filters GetSalesDocumentsFilters) ([]SaleDocument, error) {
+	filtersMap, err := sharedCommon.EncodeFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli.GetSalesDocuments(ctx, filtersMap)
+}
+
 func (cli *Client) GetSalesDocumentsWithStatus(ctx context.Context, filters map[string]string) (*GetSalesDocumentResponse, error) {
 	resp, err := cli.SendRequest(ctx, "getSalesDocuments", filters)
 	if err != nil {
 		return nil, sharedCommon.NewFromError("GetSalesDocument request failed", err, 0)
 	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, sharedCommon.NewFromError("reading GetSalesDocumentResponse failed", err, 0)
+	}
 	res := &GetSalesDocumentResponse{}
-	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+	if err := json.Unmarshal(body, &res); err != nil {
 		return nil, sharedCommon.NewFromError("unmarshaling GetSalesDocumentResponse failed", err, 0)
 	}
 
@@ -164,6 +180,10 @@ func (cli *Client) GetSalesDocumentsWithStatus(ctx context.Context, filters map[
 		return nil, sharedCommon.NewFromResponseStatus(&res.Status)
 	}
 
+	if err := cli.DecodeExtraFields(body, res.SalesDocuments); err != nil {
+		return nil, err
+	}
+
 	if len(res.SalesDocuments) == 0 {
 		//intentionally, otherwise when the documents are cached the error will be triggered.
 		return nil, nil
@@ -192,27 +212,21 @@ func (cli *Client) GetSalesDocumentsBulk(ctx context.Context, bulkFilters []map[
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetSaleDocumentResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetSaleDocumentResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(
-			bulkResp.Status.ErrorCode.String(),
-			bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus,
-			bulkResp.Status.ErrorCode,
-		)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkItem.Status.ErrorCode.String(),
-				bulkItem.Status.Request+": "+bulkItem.Status.ResponseStatus,
-				bulkItem.Status.ErrorCode,
-			)
-		}
+	if err := cli.DecodeBulkExtraFields(body, func(i int) interface{} {
+		return bulkResp.BulkItems[i].SaleDocuments
+	}); err != nil {
+		return bulkResp, err
 	}
 
-	return bulkResp, nil
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
+
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) DeleteDocument(ctx context.Context, filters map[string]string) error {
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 //works
@@ -37,7 +38,7 @@ func TestSalesDocuments(t *testing.T) {
 
 		for _, r := range saleDocs[0].InvoiceRows {
 			t.Logf("row's code2: %s", r.Code2)
-			t.Logf(r.StableRowID)
+			t.Logf("row's stable id: %d", r.StableRowID)
 		}
 	})
 
@@ -293,6 +294,146 @@ func TestSavePurchaseDocumentBulk(t *testing.T) {
 	assert.Equal(t, 124, bulkResp.BulkItems[1].Records[0].InvoiceID)
 }
 
+func TestGetSalesDocumentsMixedNumberTypes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"status":{"responseStatus":"ok"},"records":[
+			{"id":1,"paid":"10.50","confirmed":"1","paymentDays":"14","rows":[{"productID":"100","amount":"2.000","price":3.5}]},
+			{"id":2,"paid":0,"confirmed":0,"paymentDays":0,"rows":[{"productID":101,"amount":1,"price":""}]}
+		]}`))
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	cli := common.NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	cl := NewClient(cli)
+
+	docs, err := cl.GetSalesDocuments(context.Background(), map[string]string{})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	assert.Len(t, docs, 2)
+	assert.Equal(t, sharedCommon.MustParseMoney("10.5"), docs[0].Paid)
+	assert.True(t, docs[0].Confirmed.Bool())
+	assert.Equal(t, 14, docs[0].PaymentDays.Int())
+	assert.Equal(t, 100, docs[0].InvoiceRows[0].ProductID.Int())
+	assert.Equal(t, 2.0, docs[0].InvoiceRows[0].Amount.Float64())
+	assert.Equal(t, sharedCommon.MustParseMoney("3.5"), docs[0].InvoiceRows[0].Price)
+
+	assert.True(t, docs[1].Paid.IsZero())
+	assert.False(t, docs[1].Confirmed.Bool())
+	assert.Equal(t, 101, docs[1].InvoiceRows[0].ProductID.Int())
+	assert.True(t, docs[1].InvoiceRows[0].Price.IsZero())
+}
+
+func TestGetSalesDocumentsDates(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		common.AssertFormValues(t, r, map[string]interface{}{
+			"request":      "getSalesDocuments",
+			"dateFrom":     "2020-01-01",
+			"changedSince": "1577836800",
+		})
+
+		_, err := w.Write([]byte(`{"status":{"responseStatus":"ok"},"records":[
+			{"id":1,"date":"2020-01-31","time":"13:45:00","deliveryDate":"0000-00-00","lastModified":1580471100,"added":"1580471100"}
+		]}`))
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	tallinn, err := time.LoadLocation("Europe/Tallinn")
+	assert.NoError(t, err)
+
+	constr := &common.ClientConstructor{}
+	constr.WithSessionKey("somesess")
+	constr.WithClientCode("someclient")
+	constr.WithURL(srv.URL)
+	constr.WithLocation(tallinn)
+	cli := constr.Build()
+
+	cl := NewClient(cli)
+
+	docs, err := cl.GetSalesDocumentsWithFilters(context.Background(), GetSalesDocumentsFilters{
+		DateFrom:     "2020-01-01",
+		ChangedSince: time.Unix(1577836800, 0),
+	})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	assert.Len(t, docs, 1)
+	dateTime, err := docs[0].DateTime(cli.GetLocation())
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 1, 31, 13, 45, 0, 0, tallinn), dateTime)
+	assert.True(t, docs[0].DeliveryDate.IsZero())
+	assert.Equal(t, dateTime, docs[0].LastModified.Time(cli.GetLocation()))
+	assert.Equal(t, dateTime, docs[0].Added.Time(cli.GetLocation()))
+}
+
+func TestSaveSalesDocumentWithInput(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		common.AssertFormValues(t, r, map[string]interface{}{
+			"request":         "saveSalesDocument",
+			"type":            "INVWAYBILL",
+			"customerID":      "5",
+			"confirmInvoice":  "1",
+			"productID1":      "100",
+			"amount1":         "2",
+			"price1":          "9.99",
+			"productID2":      "101",
+			"amount2":         "1.5",
+			"price2":          "",
+			"attributeName1":  "source",
+			"attributeType1":  "text",
+			"attributeValue1": "webshop",
+		})
+
+		resp := PostSalesDocumentResponse{
+			Status:        sharedCommon.Status{ResponseStatus: "ok"},
+			ImportReports: SaleDocImportReports{{InvoiceID: 123}},
+		}
+		jsonRaw, err := json.Marshal(resp)
+		assert.NoError(t, err)
+
+		_, err = w.Write(jsonRaw)
+		assert.NoError(t, err)
+	}))
+
+	defer srv.Close()
+
+	cli := common.NewClient("somesess", "someclient", "", nil, nil)
+	cli.Url = srv.URL
+
+	cl := NewClient(cli)
+
+	confirm := true
+	reports, err := cl.SaveSalesDocumentWithInput(context.Background(), SalesDocumentInput{
+		Type:           "INVWAYBILL",
+		CustomerID:     5,
+		ConfirmInvoice: &confirm,
+		Rows: []SalesDocumentRowInput{
+			{ProductID: 100, Amount: 2, Price: sharedCommon.MustParseMoney("9.99")},
+			{ProductID: 101, Amount: 1.5},
+		},
+		Attributes: []sharedCommon.ObjAttribute{
+			{AttributeName: "source", AttributeType: "text", AttributeValue: "webshop"},
+		},
+	})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	assert.Len(t, reports, 1)
+	assert.Equal(t, 123, reports[0].InvoiceID)
+}
+
 func TestSaveSalesDocumentBulk(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		statusBulk := sharedCommon.StatusBulk{}
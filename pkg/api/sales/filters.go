@@ -0,0 +1,29 @@
+package sales
+
+import (
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+	"time"
+)
+
+//GetSalesDocumentsFilters are the typed filters of getSalesDocuments
+type GetSalesDocumentsFilters struct {
+	ID            int      `json:"id"`
+	IDs           []int    `json:"ids"`
+	Number        string   `json:"number"`
+	Types         []string `json:"types"`
+	ClientID      int      `json:"clientID"`
+	WarehouseID   int      `json:"warehouseID"`
+	PointOfSaleID int      `json:"pointOfSaleID"`
+	//DateFrom and DateTo are dates in the Y-m-d format
+	DateFrom              sharedCommon.Date `json:"dateFrom"`
+	DateTo                sharedCommon.Date `json:"dateTo"`
+	Confirmed             *bool             `json:"confirmed"`
+	ChangedSince          time.Time         `json:"changedSince"`
+	GetRowsForAllInvoices bool              `json:"getRowsForAllInvoices"`
+	GetAddedTimestamp     bool              `json:"getAddedTimestamp"`
+	GetReturnedPayments   bool              `json:"getReturnedPayments"`
+	NonZeroBalanceOnly    bool              `json:"nonZeroBalanceOnly"`
+	OrderBy               string            `json:"orderBy"`
+	OrderByDir            string            `json:"orderByDir"`
+	sharedCommon.Pagination
+}
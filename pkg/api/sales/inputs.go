@@ -0,0 +1,45 @@
+package sales
+
+import (
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+type (
+	//SalesDocumentInput is the typed input of saveSalesDocument, the document is updated if ID is set
+	SalesDocumentInput struct {
+		ID           int    `json:"id"`
+		Type         string `json:"type"`
+		CurrencyCode string `json:"currencyCode"`
+		//Date is in the Y-m-d format and Time in the H:i:s format
+		Date                  sharedCommon.Date           `json:"date"`
+		Time                  string                      `json:"time"`
+		WarehouseID           int                         `json:"warehouseID"`
+		PointOfSaleID         int                         `json:"pointOfSaleID"`
+		CustomerID            int                         `json:"customerID"`
+		PayerID               int                         `json:"payerID"`
+		AddressID             int                         `json:"addressID"`
+		EmployeeID            int                         `json:"employeeID"`
+		InvoiceState          string                      `json:"invoiceState"`
+		PaymentType           string                      `json:"paymentType"`
+		PaymentDays           int                         `json:"paymentDays"`
+		ConfirmInvoice        *bool                       `json:"confirmInvoice"`
+		Notes                 string                      `json:"notes"`
+		InternalNotes         string                      `json:"internalNotes"`
+		CustomReferenceNumber string                      `json:"customReferenceNumber"`
+		Rows                  []SalesDocumentRowInput     `json:"rows,indexed"`
+		Attributes            []sharedCommon.ObjAttribute `json:"attributes,indexed"`
+	}
+
+	//SalesDocumentRowInput is a row of SalesDocumentInput, it's encoded with the row number e.g. productID1, amount1,
+	//the price of the product is used if Price is not set
+	SalesDocumentRowInput struct {
+		RowID      int                `json:"rowID"`
+		ProductID  int                `json:"productID"`
+		ItemName   string             `json:"itemName"`
+		VatrateID  int                `json:"vatrateID"`
+		Amount     float64            `json:"amount"`
+		Price      sharedCommon.Money `json:"price"`
+		Discount   float64            `json:"discount"`
+		EmployeeID int                `json:"employeeID"`
+	}
+)
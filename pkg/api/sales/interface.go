@@ -9,12 +9,14 @@ type (
 	}
 	DocumentManager interface {
 		SaveSalesDocument(ctx context.Context, filters map[string]string) (SaleDocImportReports, error)
+		SaveSalesDocumentWithInput(ctx context.Context, input SalesDocumentInput) (SaleDocImportReports, error)
 		SaveSalesDocumentBulk(
 			ctx context.Context,
 			bulkFilters []map[string]interface{},
 			baseFilters map[string]string,
 		) (respBulk SaveSalesDocumentResponseBulk, err error)
 		GetSalesDocuments(ctx context.Context, filters map[string]string) ([]SaleDocument, error)
+		GetSalesDocumentsWithFilters(ctx context.Context, filters GetSalesDocumentsFilters) ([]SaleDocument, error)
 		GetSalesDocumentsWithStatus(ctx context.Context, filters map[string]string) (*GetSalesDocumentResponse, error)
 		GetSalesDocumentsBulk(ctx context.Context, bulkFilters []map[string]interface{}, baseFilters map[string]string) (GetSaleDocumentResponseBulk, error)
 		DeleteDocument(ctx context.Context, filters map[string]string) error
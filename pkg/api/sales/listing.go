@@ -2,15 +2,18 @@ package sales
 
 import (
 	"context"
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
 )
 
 type SaleDocumentsListingDataProvider struct {
 	erplyAPI Manager
+	sharedCommon.ListingClient
 }
 
 func NewSaleDocumentsListingDataProvider(erplyClient Manager) *SaleDocumentsListingDataProvider {
 	return &SaleDocumentsListingDataProvider{
-		erplyAPI: erplyClient,
+		erplyAPI:      erplyClient,
+		ListingClient: sharedCommon.NewListingClient(erplyClient),
 	}
 }
 
@@ -48,11 +51,13 @@ func (sdldp *SaleDocumentsListingDataProvider) Read(ctx context.Context, bulkFil
 
 type VatRatesListingDataProvider struct {
 	erplyAPI Manager
+	sharedCommon.ListingClient
 }
 
 func NewVatRatesListingDataProvider(erplyClient Manager) *VatRatesListingDataProvider {
 	return &VatRatesListingDataProvider{
-		erplyAPI: erplyClient,
+		erplyAPI:      erplyClient,
+		ListingClient: sharedCommon.NewListingClient(erplyClient),
 	}
 }
 
@@ -16,27 +16,27 @@ type (
 	}
 
 	Project struct {
-		ProjectID    uint   `json:"projectID"`
-		Name         string `json:"name"`
-		CustomerID   uint   `json:"customerID"`
-		CustomerName string `json:"customerName"`
-		EmployeeID   uint   `json:"employeeID"`
-		EmployeeName string `json:"employeeName"`
-		TypeID       uint   `json:"typeID"`
-		TypeName     string `json:"typeName"`
-		StatusID     uint   `json:"statusID"`
-		StatusName   string `json:"statusName"`
-		StartDate    string `json:"startDate"`
-		EndDate      string `json:"endDate"`
-		Notes        string `json:"notes"`
-		LastModified uint64 `json:"lastModified"`
+		ProjectID    uint              `json:"projectID"`
+		Name         string            `json:"name"`
+		CustomerID   uint              `json:"customerID"`
+		CustomerName string            `json:"customerName"`
+		EmployeeID   uint              `json:"employeeID"`
+		EmployeeName string            `json:"employeeName"`
+		TypeID       uint              `json:"typeID"`
+		TypeName     string            `json:"typeName"`
+		StatusID     uint              `json:"statusID"`
+		StatusName   string            `json:"statusName"`
+		StartDate    common2.Date      `json:"startDate"`
+		EndDate      common2.Date      `json:"endDate"`
+		Notes        string            `json:"notes"`
+		LastModified common2.Timestamp `json:"lastModified"`
 	}
 
 	ProjectStatus struct {
-		ProjectStatusID uint   `json:"projectStatusID"`
-		Name            string `json:"name"`
-		Finished        byte   `json:"finished"`
-		Added           uint64 `json:"added"`
-		LastModified    uint64 `json:"lastModified"`
+		ProjectStatusID uint              `json:"projectStatusID"`
+		Name            string            `json:"name"`
+		Finished        byte              `json:"finished"`
+		Added           common2.Timestamp `json:"added"`
+		LastModified    common2.Timestamp `json:"lastModified"`
 	}
 )
@@ -23,11 +23,7 @@ func (cli *Client) GetSalesReport(ctx context.Context, filters map[string]string
 		return nil, sharedCommon.NewFromError("getSalesReport: unmarshaling response failed", err, 0)
 	}
 	if !common.IsJSONResponseOK(&salesReportResp.Status) {
-		return &salesReportResp, sharedCommon.NewErplyError(
-			salesReportResp.Status.ErrorCode.String(),
-			salesReportResp.Status.Request+": "+salesReportResp.Status.ResponseStatus,
-			salesReportResp.Status.ErrorCode,
-		)
+		return &salesReportResp, sharedCommon.NewFromResponseStatus(&salesReportResp.Status)
 	}
 	if len(salesReportResp.Records) < 1 {
 		return &salesReportResp, sharedCommon.NewFromError("getSalesReport: no records in response", nil, salesReportResp.Status.ErrorCode)
@@ -1,20 +1,22 @@
 package sales
 
+import sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+
 type ShoppingCartTotals struct {
 	Rows     []ShoppingCartProduct `json:"rows"`
-	NetTotal float64               `json:"netTotal"`
-	VATTotal float64               `json:"vatTotal"`
-	Total    float64               `json:"total"`
+	NetTotal sharedCommon.Money    `json:"netTotal"`
+	VATTotal sharedCommon.Money    `json:"vatTotal"`
+	Total    sharedCommon.Money    `json:"total"`
 }
 
 type ShoppingCartProduct struct {
-	ProductID            string  `json:"productID"`
-	Amount               string  `json:"amount"`
-	OriginalPrice        float64 `json:"originalPrice"`
-	OriginalPriceWithVAT float64 `json:"originalPriceWithVAT"`
-	FinalPrice           float64 `json:"finalPrice"`
-	FinalPriceWithVAT    float64 `json:"finalPriceWithVAT"`
-	RowNetTotal          float64 `json:"rowNetTotal"`
-	RowTotal             float64 `json:"rowTotal"`
-	Discount             float64 `json:"discount"`
+	ProductID            sharedCommon.FlexInt   `json:"productID"`
+	Amount               sharedCommon.FlexFloat `json:"amount"`
+	OriginalPrice        sharedCommon.Money     `json:"originalPrice"`
+	OriginalPriceWithVAT sharedCommon.Money     `json:"originalPriceWithVAT"`
+	FinalPrice           sharedCommon.Money     `json:"finalPrice"`
+	FinalPriceWithVAT    sharedCommon.Money     `json:"finalPriceWithVAT"`
+	RowNetTotal          sharedCommon.Money     `json:"rowNetTotal"`
+	RowTotal             sharedCommon.Money     `json:"rowTotal"`
+	Discount             float64                `json:"discount"`
 }
@@ -28,7 +28,7 @@ func (cli *Client) CalculateShoppingCart(ctx context.Context, filters map[string
 		return nil, sharedCommon.NewFromError("CalculateShoppingCart: unmarshaling response failed", err, 0)
 	}
 	if !common.IsJSONResponseOK(&respData.Status) {
-		return nil, sharedCommon.NewErplyError(respData.Status.ErrorCode.String(), respData.Status.Request+": "+respData.Status.ResponseStatus, respData.Status.ErrorCode)
+		return nil, sharedCommon.NewFromResponseStatus(&respData.Status)
 	}
 	if len(respData.Records) < 1 {
 		return nil, sharedCommon.NewFromError("CalculateShoppingCart: no records in response", nil, respData.Status.ErrorCode)
@@ -12,7 +12,7 @@ type (
 		Code   string `json:"code"`
 		Active string `json:"active"`
 		//Added        string `json:"added"`
-		LastModified string `json:"lastModified"`
+		LastModified sharedCommon.Timestamp `json:"lastModified"`
 		//IsReverseVat int    `json:"isReverseVat"`
 		//ReverseRate int `json:"reverseRate"`
 	}
@@ -20,8 +20,8 @@ type (
 	VatRates []VatRate
 
 	NetTotalsByTaxRate struct {
-		VatrateID int     `json:"vatrateID"`
-		Total     float64 `json:"total"`
+		VatrateID int                `json:"vatrateID"`
+		Total     sharedCommon.Money `json:"total"`
 	}
 
 	//GetVatRatesResponse ...
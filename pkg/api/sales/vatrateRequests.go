@@ -50,22 +50,17 @@ func (cli *Client) GetVatRatesBulk(ctx context.Context, bulkFilters []map[string
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetVatRatesResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetVatRatesResponseBulk from '%s': %v", common2.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, common2.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, common2.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkItem.Status.Status) {
-			return bulkResp, common2.NewErplyError(bulkItem.Status.ErrorCode.String(), bulkItem.Status.Request+": "+bulkItem.Status.ResponseStatus, bulkResp.Status.ErrorCode)
-		}
-	}
+	_, bulkStatuses := common2.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, common2.NewFromBulkStatuses(bulkStatuses)
 }
 
-
 func (cli *Client) SaveVatRate(ctx context.Context, filters map[string]string) (*SaveVatRateResult, error) {
 	resp, err := cli.SendRequest(ctx, "saveVatRate", filters)
 	if err != nil {
@@ -78,7 +73,7 @@ func (cli *Client) SaveVatRate(ctx context.Context, filters map[string]string) (
 
 	res := &SaveVatRateResultResponse{}
 	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, fmt.Errorf("ERPLY API: failed to unmarshal SaveVatRateResultResponse from '%s': %v", string(body), err)
+		return nil, fmt.Errorf("ERPLY API: failed to unmarshal SaveVatRateResultResponse from '%s': %v", common2.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&res.Status) {
@@ -95,10 +90,6 @@ func (cli *Client) SaveVatRate(ctx context.Context, filters map[string]string) (
 func (cli *Client) SaveVatRateBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (SaveVatRateResponseBulk, error) {
 	var bulkResp SaveVatRateResponseBulk
 
-	if len(bulkRequest) > common2.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot save more than %d price lists in one bulk request", common2.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, bulkInput := range bulkRequest {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -118,24 +109,16 @@ func (cli *Client) SaveVatRateBulk(ctx context.Context, bulkRequest []map[string
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal SaveVatRateResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal SaveVatRateResponseBulk from '%s': %v", common2.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, common2.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, common2.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, common2.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := common2.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, common2.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SaveVatRateComponent(ctx context.Context, filters map[string]string) (*SaveVatRateComponentResult, error) {
@@ -150,7 +133,7 @@ func (cli *Client) SaveVatRateComponent(ctx context.Context, filters map[string]
 
 	res := &SaveVatRateComponentResultResponse{}
 	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, fmt.Errorf("ERPLY API: failed to unmarshal SaveVatRateComponentResultResponse from '%s': %v", string(body), err)
+		return nil, fmt.Errorf("ERPLY API: failed to unmarshal SaveVatRateComponentResultResponse from '%s': %v", common2.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&res.Status) {
@@ -167,10 +150,6 @@ func (cli *Client) SaveVatRateComponent(ctx context.Context, filters map[string]
 func (cli *Client) SaveVatRateComponentBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (SaveVatRateComponentResponseBulk, error) {
 	var bulkResp SaveVatRateComponentResponseBulk
 
-	if len(bulkRequest) > common2.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot save more than %d price lists in one bulk request", common2.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, bulkInput := range bulkRequest {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -190,22 +169,14 @@ func (cli *Client) SaveVatRateComponentBulk(ctx context.Context, bulkRequest []m
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal SaveVatRateComponentResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal SaveVatRateComponentResponseBulk from '%s': %v", common2.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, common2.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, common2.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, common2.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := common2.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, common2.NewFromBulkStatuses(bulkStatuses)
 }
@@ -40,7 +40,7 @@ func TestVatRateManager(t *testing.T) {
 func TestSaveVatRate(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "someclient", r.URL.Query().Get("clientCode"))
-		assert.Equal(t, "somesess", r.URL.Query().Get("sessionKey"))
+		assert.Equal(t, "somesess", r.PostFormValue("sessionKey"))
 		assert.Equal(t, "saveVatRate", r.URL.Query().Get("request"))
 		assert.Equal(t, "ID123", r.URL.Query().Get("vatRateID"))
 		assert.Equal(t, "VatName", r.URL.Query().Get("name"))
@@ -179,7 +179,7 @@ func TestSaveVatRateBulk(t *testing.T) {
 func TestSaveVatRateComponent(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "someclient", r.URL.Query().Get("clientCode"))
-		assert.Equal(t, "somesess", r.URL.Query().Get("sessionKey"))
+		assert.Equal(t, "somesess", r.PostFormValue("sessionKey"))
 		assert.Equal(t, "saveVatRateComponent", r.URL.Query().Get("request"))
 		assert.Equal(t, "ID123", r.URL.Query().Get("vatRateComponentID"))
 		assert.Equal(t, "#2333", r.URL.Query().Get("vatRateID"))
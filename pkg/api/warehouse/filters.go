@@ -0,0 +1,16 @@
+package warehouse
+
+import (
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//GetWarehousesFilters are the typed filters of getWarehouses
+type GetWarehousesFilters struct {
+	WarehouseID          int    `json:"warehouseID"`
+	WarehouseIDs         []int  `json:"warehouseIDs"`
+	SearchAttributeName  string `json:"searchAttributeName"`
+	SearchAttributeValue string `json:"searchAttributeValue"`
+	Lang                 string `json:"lang"`
+	GetAllLanguages      bool   `json:"getAllLanguages"`
+	sharedCommon.Pagination
+}
@@ -0,0 +1,20 @@
+package warehouse
+
+import (
+	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
+)
+
+//SaveWarehouseInput is the typed input of saveWarehouse, the warehouse is updated if WarehouseID is set
+type SaveWarehouseInput struct {
+	WarehouseID      int                         `json:"warehouseID"`
+	Name             string                      `json:"name"`
+	Code             string                      `json:"code"`
+	AddressID        int                         `json:"addressID"`
+	CompanyName      string                      `json:"companyName"`
+	CompanyCode      string                      `json:"companyCode"`
+	CompanyVatNumber string                      `json:"companyVatNumber"`
+	Phone            string                      `json:"phone"`
+	Email            string                      `json:"email"`
+	Website          string                      `json:"website"`
+	Attributes       []sharedCommon.ObjAttribute `json:"attributes,indexed"`
+}
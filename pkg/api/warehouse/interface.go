@@ -5,6 +5,7 @@ import "context"
 type (
 	Manager interface {
 		GetWarehouses(ctx context.Context, filters map[string]string) (Warehouses, error)
+		GetWarehousesWithFilters(ctx context.Context, filters GetWarehousesFilters) (Warehouses, error)
 		GetWarehousesBulk(
 			ctx context.Context,
 			bulkRequest []map[string]interface{},
@@ -13,6 +14,7 @@ type (
 			error,
 		)
 		SaveWarehouse(ctx context.Context, filters map[string]string) (*SaveWarehouseResult, error)
+		SaveWarehouseWithInput(ctx context.Context, input SaveWarehouseInput) (*SaveWarehouseResult, error)
 		SaveWarehouseBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (SaveWarehouseResponseBulk, error)
 		InventoryManager
 	}
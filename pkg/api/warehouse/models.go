@@ -1,7 +1,6 @@
 package warehouse
 
 import (
-	"encoding/json"
 	sharedCommon "github.com/erply/api-go-wrapper/pkg/api/common"
 )
 
@@ -12,39 +11,42 @@ type (
 	}
 
 	Warehouse struct {
-		WarehouseID            string      `json:"warehouseID"`
-		PricelistID            json.Number `json:"pricelistID"`
-		PricelistID2           json.Number `json:"pricelistID2"`
-		PricelistID3           json.Number `json:"pricelistID3"`
-		PricelistID4           json.Number `json:"pricelistID4"`
-		PricelistID5           json.Number `json:"pricelistID5"`
-		Name                   string      `json:"name"`
-		Code                   string      `json:"code"`
-		AddressID              int         `json:"addressID"`
-		Address                string      `json:"address"`
-		Street                 string      `json:"street"`
-		Address2               string      `json:"address2"`
-		City                   string      `json:"city"`
-		State                  string      `json:"state"`
-		Country                string      `json:"country"`
-		ZIPcode                string      `json:"ZIPcode"`
-		StoreGroups            string      `json:"storeGroups"`
-		CompanyName            string      `json:"companyName"`
-		CompanyCode            string      `json:"companyCode"`
-		CompanyVatNumber       string      `json:"companyVatNumber"`
-		Phone                  string      `json:"phone"`
-		Fax                    string      `json:"fax"`
-		Email                  string      `json:"email"`
-		Website                string      `json:"website"`
-		BankName               string      `json:"bankName"`
-		BankAccountNumber      string      `json:"bankAccountNumber"`
-		Iban                   string      `json:"iban"`
-		Swift                  string      `json:"swift"`
-		UsesLocalQuickButtons  int         `json:"usesLocalQuickButtons"`
-		DefaultCustomerGroupID int         `json:"defaultCustomerGroupID"`
-		IsOfflineInventory     int         `json:"isOfflineInventory"`
-		TimeZone               string      `json:"timeZone"`
+		WarehouseID            string               `json:"warehouseID"`
+		PricelistID            sharedCommon.FlexInt `json:"pricelistID"`
+		PricelistID2           sharedCommon.FlexInt `json:"pricelistID2"`
+		PricelistID3           sharedCommon.FlexInt `json:"pricelistID3"`
+		PricelistID4           sharedCommon.FlexInt `json:"pricelistID4"`
+		PricelistID5           sharedCommon.FlexInt `json:"pricelistID5"`
+		Name                   string               `json:"name"`
+		Code                   string               `json:"code"`
+		AddressID              int                  `json:"addressID"`
+		Address                string               `json:"address"`
+		Street                 string               `json:"street"`
+		Address2               string               `json:"address2"`
+		City                   string               `json:"city"`
+		State                  string               `json:"state"`
+		Country                string               `json:"country"`
+		ZIPcode                string               `json:"ZIPcode"`
+		StoreGroups            string               `json:"storeGroups"`
+		CompanyName            string               `json:"companyName"`
+		CompanyCode            string               `json:"companyCode"`
+		CompanyVatNumber       string               `json:"companyVatNumber"`
+		Phone                  string               `json:"phone"`
+		Fax                    string               `json:"fax"`
+		Email                  string               `json:"email"`
+		Website                string               `json:"website"`
+		BankName               string               `json:"bankName"`
+		BankAccountNumber      string               `json:"bankAccountNumber"`
+		Iban                   string               `json:"iban"`
+		Swift                  string               `json:"swift"`
+		UsesLocalQuickButtons  int                  `json:"usesLocalQuickButtons"`
+		DefaultCustomerGroupID int                  `json:"defaultCustomerGroupID"`
+		IsOfflineInventory     int                  `json:"isOfflineInventory"`
+		TimeZone               string               `json:"timeZone"`
 		sharedCommon.Attributes
+
+		//Extra contains the fields which are not declared above, it's filled only if ClientBuilder.KeepExtraFields is enabled
+		Extra sharedCommon.ExtraFields `json:"-"`
 	}
 
 	Warehouses []Warehouse
@@ -16,8 +16,13 @@ func (cli *Client) GetWarehouses(ctx context.Context, filters map[string]string)
 		return nil, err
 	}
 
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, sharedCommon.NewFromError("reading GetWarehousesResponse failed", err, 0)
+	}
+
 	res := &GetWarehousesResponse{}
-	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+	if err := json.Unmarshal(body, &res); err != nil {
 		return nil, sharedCommon.NewFromError("unmarshaling GetWarehousesResponse failed", err, 0)
 	}
 
@@ -25,6 +30,10 @@ func (cli *Client) GetWarehouses(ctx context.Context, filters map[string]string)
 		return nil, sharedCommon.NewFromResponseStatus(&res.Status)
 	}
 
+	if err := cli.DecodeExtraFields(body, res.Warehouses); err != nil {
+		return nil, err
+	}
+
 	if len(res.Warehouses) == 0 {
 		return nil, nil
 	}
@@ -32,6 +41,16 @@ func (cli *Client) GetWarehouses(ctx context.Context, filters map[string]string)
 	return res.Warehouses, nil
 }
 
+//GetWarehousesWithFilters is GetWarehouses with the typed filters
+func (cli *Client) GetWarehousesWithFilters(ctx context.Context, filters GetWarehousesFilters) (Warehouses, error) {
+	filtersMap, err := sharedCommon.EncodeFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli.GetWarehouses(ctx, filtersMap)
+}
+
 func (cli *Client) GetWarehousesBulk(
 	ctx context.Context,
 	bulkFilters []map[string]interface{},
@@ -59,19 +78,21 @@ func (cli *Client) GetWarehousesBulk(
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetWarehousesResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal GetWarehousesResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(bulkItem.Status.ErrorCode.String(), bulkItem.Status.Request+": "+bulkItem.Status.ResponseStatus, bulkResp.Status.ErrorCode)
-		}
+	if err := cli.DecodeBulkExtraFields(body, func(i int) interface{} {
+		return bulkResp.BulkItems[i].Warehouses
+	}); err != nil {
+		return bulkResp, err
 	}
 
-	return bulkResp, nil
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
+
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }
 
 func (cli *Client) SaveWarehouse(ctx context.Context, filters map[string]string) (*SaveWarehouseResult, error) {
@@ -86,7 +107,7 @@ func (cli *Client) SaveWarehouse(ctx context.Context, filters map[string]string)
 
 	res := &SaveWarehouseResponse{}
 	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, fmt.Errorf("ERPLY API: failed to unmarshal SaveWarehouseResponse from '%s': %v", string(body), err)
+		return nil, fmt.Errorf("ERPLY API: failed to unmarshal SaveWarehouseResponse from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&res.Status) {
@@ -100,13 +121,19 @@ func (cli *Client) SaveWarehouse(ctx context.Context, filters map[string]string)
 	return &res.Results[0], nil
 }
 
+//SaveWarehouseWithInput is SaveWarehouse with the typed input
+func (cli *Client) SaveWarehouseWithInput(ctx context.Context, input SaveWarehouseInput) (*SaveWarehouseResult, error) {
+	filters, err := sharedCommon.EncodeFilters(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli.SaveWarehouse(ctx, filters)
+}
+
 func (cli *Client) SaveWarehouseBulk(ctx context.Context, bulkRequest []map[string]interface{}, baseFilters map[string]string) (SaveWarehouseResponseBulk, error) {
 	var bulkResp SaveWarehouseResponseBulk
 
-	if len(bulkRequest) > sharedCommon.MaxBulkRequestsCount {
-		return bulkResp, fmt.Errorf("cannot save more than %d warehouses in one bulk request", sharedCommon.MaxBulkRequestsCount)
-	}
-
 	bulkInputs := make([]common.BulkInput, 0, len(bulkRequest))
 	for _, bulkInput := range bulkRequest {
 		bulkInputs = append(bulkInputs, common.BulkInput{
@@ -126,22 +153,14 @@ func (cli *Client) SaveWarehouseBulk(ctx context.Context, bulkRequest []map[stri
 	}
 
 	if err := json.Unmarshal(body, &bulkResp); err != nil {
-		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal SaveWarehouseResponseBulk from '%s': %v", string(body), err)
+		return bulkResp, fmt.Errorf("ERPLY API: failed to unmarshal SaveWarehouseResponseBulk from '%s': %v", sharedCommon.RedactText(string(body)), err)
 	}
 
 	if !common.IsJSONResponseOK(&bulkResp.Status) {
-		return bulkResp, sharedCommon.NewErplyError(bulkResp.Status.ErrorCode.String(), bulkResp.Status.Request+": "+bulkResp.Status.ResponseStatus, bulkResp.Status.ErrorCode)
+		return bulkResp, sharedCommon.NewFromResponseStatus(&bulkResp.Status)
 	}
 
-	for _, bulkRespItem := range bulkResp.BulkItems {
-		if !common.IsJSONResponseOK(&bulkRespItem.Status.Status) {
-			return bulkResp, sharedCommon.NewErplyError(
-				bulkRespItem.Status.ErrorCode.String(),
-				fmt.Sprintf("%+v", bulkRespItem.Status),
-				bulkResp.Status.ErrorCode,
-			)
-		}
-	}
+	_, bulkStatuses := sharedCommon.DecodeStatuses(body)
 
-	return bulkResp, nil
+	return bulkResp, sharedCommon.NewFromBulkStatuses(bulkStatuses)
 }